@@ -93,7 +93,18 @@ func (s *Scraper) LoginWithCookies(path string) error {
 	}
 
 	// Set cookies on the browser page too so signing works with auth context.
-	for _, c := range s.GetCookies() {
+	if err := s.setBrowserCookies(s.GetCookies()); err != nil {
+		return err
+	}
+
+	// Sync fresh browser cookies (including new msToken) to HTTP client.
+	return s.syncCookiesFromBrowser()
+}
+
+// setBrowserCookies copies cookies into the browser and reloads the page so
+// the signing JS picks them up.
+func (s *Scraper) setBrowserCookies(cookies []*http.Cookie) error {
+	for _, c := range cookies {
 		if err := s.page.SetCookies([]*proto.NetworkCookieParam{{
 			Name:   c.Name,
 			Value:  c.Value,
@@ -112,7 +123,5 @@ func (s *Scraper) LoginWithCookies(path string) error {
 		return fmt.Errorf("wait after cookie reload: %w", err)
 	}
 	s.signingReady.Store(true)
-
-	// Sync fresh browser cookies (including new msToken) to HTTP client.
-	return s.syncCookiesFromBrowser()
+	return nil
 }
@@ -3,12 +3,16 @@
 package tiktok
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/go-rod/rod"
 	"github.com/go-rod/rod/lib/launcher"
+	"github.com/go-rod/rod/lib/launcher/flags"
 	"github.com/go-rod/rod/lib/proto"
 	"github.com/go-rod/stealth"
 )
@@ -20,10 +24,17 @@ func (s *Scraper) InitBrowser() error {
 }
 
 func (s *Scraper) launchBrowser() error {
-	l := launcher.New().Headless(true)
+	l := launcher.New().Headless(!s.headful)
 	if s.proxy != "" {
 		l = l.Proxy(s.proxy)
 	}
+	if s.browserExecPath != "" {
+		l = l.Bin(s.browserExecPath)
+	}
+	for _, f := range s.browserFlags {
+		name, values := splitBrowserFlag(f)
+		l = l.Set(flags.Flag(name), values...)
+	}
 
 	controlURL, err := l.Launch()
 	if err != nil {
@@ -32,24 +43,16 @@ func (s *Scraper) launchBrowser() error {
 
 	browser := rod.New().ControlURL(controlURL)
 	if err := browser.Connect(); err != nil {
+		l.Kill()
 		return fmt.Errorf("connect browser: %w", err)
 	}
 
-	page, err := stealth.Page(browser)
-	if err != nil {
-		return fmt.Errorf("create stealth page: %w", err)
-	}
-
 	s.browser = browser
-	s.page = page
-
 	s.setupResourceBlocking()
-
-	if err := s.page.Navigate(s.baseURL); err != nil {
-		return fmt.Errorf("navigate to tiktok: %w", err)
-	}
-	if err := s.page.WaitStable(2 * time.Second); err != nil {
-		return fmt.Errorf("wait for page stable: %w", err)
+	if err := s.openSigningPages(); err != nil {
+		// Leave no half-initialized browser behind for Close or a restart.
+		_ = s.closeBrowser()
+		return err
 	}
 
 	// Cache that signing is ready after initial page load.
@@ -59,9 +62,95 @@ func (s *Scraper) launchBrowser() error {
 	return s.syncCookiesFromBrowser()
 }
 
+// relaunchBrowser launches a replacement for a crashed browser and restores
+// the session from the HTTP cookie jar, which outlives the browser.
+func (s *Scraper) relaunchBrowser() error {
+	// Snapshot first: launchBrowser syncs the new browser's cookies into the jar.
+	cookies := s.GetCookies()
+	if err := s.launchBrowser(); err != nil {
+		return err
+	}
+	if !s.isLogged {
+		return nil
+	}
+	return s.setBrowserCookies(cookies)
+}
+
+// newSigningPage opens a stealth page and loads TikTok so the signing JS is
+// available in it.
+func (s *Scraper) newSigningPage() (*rod.Page, error) {
+	page, err := stealth.Page(s.browser)
+	if err != nil {
+		return nil, fmt.Errorf("create stealth page: %w", err)
+	}
+	if err := page.Navigate(s.baseURL); err != nil {
+		return nil, fmt.Errorf("navigate to tiktok: %w", err)
+	}
+	if err := page.WaitStable(2 * time.Second); err != nil {
+		return nil, fmt.Errorf("wait for page stable: %w", err)
+	}
+	return page, nil
+}
+
+// openSigningPages opens s.page and, with WithBrowserPoolSize, the pooled pages.
+func (s *Scraper) openSigningPages() error {
+	page, err := s.newSigningPage()
+	if err != nil {
+		return err
+	}
+	s.page = page
+
+	if s.browserPoolSize > 1 {
+		if s.pool, err = s.launchBrowserPool(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// launchBrowserPool opens browserPoolSize-1 extra signing pages in parallel
+// and returns a pool holding them together with s.page. If any page fails,
+// the ones that opened are closed.
+func (s *Scraper) launchBrowserPool() (*browserPool, error) {
+	pool := newBrowserPool(s.browserPoolSize)
+	pool.put(s.page)
+
+	errs := make(chan error, s.browserPoolSize-1)
+	var wg sync.WaitGroup
+	for range s.browserPoolSize - 1 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			page, err := s.newSigningPage()
+			if err != nil {
+				errs <- err
+				return
+			}
+			pool.put(page)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		close(pool.pages)
+		for page := range pool.pages {
+			if page != s.page {
+				_ = page.Close()
+			}
+		}
+		return nil, fmt.Errorf("browser pool: %w", err)
+	}
+	return pool, nil
+}
+
 func (s *Scraper) setupResourceBlocking() {
 	router := s.browser.HijackRequests()
 	blocked := []string{"*.css", "*.png", "*.jpg", "*.jpeg", "*.mp4", "*.woff*", "*.svg", "*analytics*"}
+	if s.headful {
+		// Let the page render visibly; media and tracking stay blocked.
+		blocked = []string{"*.mp4", "*analytics*"}
+	}
 	for _, pattern := range blocked {
 		router.MustAdd(pattern, func(ctx *rod.Hijack) {
 			ctx.Response.Fail(proto.NetworkErrorReasonBlockedByClient)
@@ -70,6 +159,27 @@ func (s *Scraper) setupResourceBlocking() {
 	go router.Run()
 }
 
+// withScreenshot saves a screenshot of page if WithScreenshotOnError is set
+// and returns err wrapped in an *ErrWithScreenshot. If the screenshot
+// cannot be taken, err is returned unchanged.
+func (s *Scraper) withScreenshot(page *rod.Page, err error) error {
+	if s.screenshotDir == "" {
+		return err
+	}
+	// Detach from the failed call's context, which may have timed out.
+	png, shotErr := page.Context(context.Background()).Timeout(5*time.Second).Screenshot(false, &proto.PageCaptureScreenshot{})
+	if shotErr != nil {
+		s.logger().Warn("signing failure screenshot", slog.Any("error", shotErr))
+		return err
+	}
+	path, shotErr := saveScreenshot(s.screenshotDir, png, time.Now())
+	if shotErr != nil {
+		s.logger().Warn("signing failure screenshot", slog.Any("error", shotErr))
+		return err
+	}
+	return &ErrWithScreenshot{Err: err, Path: path}
+}
+
 // signURL calls TikTok's frontierSign JS to generate the X-Bogus signature.
 // frontierSign returns an object like {"X-Bogus": "xxx"} — we append those
 // params to the original URL.
@@ -79,7 +189,7 @@ func (s *Scraper) signURL(rawURL string) (string, error) {
 		return "", ErrBrowserNotReady
 	}
 
-	if err := s.ensureSigningReady(); err != nil {
+	if err := s.ensureSigningReady(s.page); err != nil {
 		return "", fmt.Errorf("ensure signing ready: %w", err)
 	}
 
@@ -87,6 +197,7 @@ func (s *Scraper) signURL(rawURL string) (string, error) {
 	page := s.page.Timeout(5 * time.Second)
 
 	// Returns the signed URL directly by appending params from frontierSign.
+	s.browserSignings.Add(1)
 	result, err := page.Eval(`(url) => {
 		if (typeof window.byted_acrawler === 'undefined') {
 			throw new Error('signing function not available');
@@ -105,34 +216,36 @@ func (s *Scraper) signURL(rawURL string) (string, error) {
 	if err != nil {
 		// Mark signing as not ready so next call will reload.
 		s.signingReady.Store(false)
-		return "", fmt.Errorf("%w: %v", ErrSigningFailed, err)
+		return "", s.withScreenshot(s.page, fmt.Errorf("%w: %v", ErrSigningFailed, err))
 	}
 
 	return result.Value.String(), nil
 }
 
-// browserFetch signs a URL and fetches it inside the browser via JS fetch().
-// This ensures the request uses the browser's TLS fingerprint, cookies, and
-// session — avoiding detection from fingerprint mismatches between Go's
-// net/http client and the browser that signed the URL.
-// Caller must hold browserMu.
-func (s *Scraper) browserFetch(rawURL string) ([]byte, error) {
+// browserFetch signs a URL and fetches it inside the browser page via JS
+// fetch(). This ensures the request uses the browser's TLS fingerprint,
+// cookies, and session — avoiding detection from fingerprint mismatches
+// between Go's net/http client and the browser that signed the URL.
+// Caller must have exclusive use of page (browserMu or the browser pool).
+func (s *Scraper) browserFetch(page *rod.Page, rawURL string) (body []byte, err error) {
 	totalStart := time.Now()
+	defer func() { s.recordBrowserFetch(body, err) }()
 
-	if s.page == nil {
+	if page == nil {
 		return nil, ErrBrowserNotReady
 	}
 
 	signingStart := time.Now()
-	if err := s.ensureSigningReady(); err != nil {
+	if err := s.ensureSigningReady(page); err != nil {
 		return nil, fmt.Errorf("ensure signing ready: %w", err)
 	}
-	perfLog("browserFetch: ensureSigningReady=%v", time.Since(signingStart))
+	s.logTiming(context.Background(), "ensureSigningReady", time.Since(signingStart))
 
-	page := s.page.Timeout(15 * time.Second)
+	page = page.Timeout(15 * time.Second)
 
 	// Sign the URL and fetch it in one JS call to keep everything consistent.
 	evalStart := time.Now()
+	s.browserSignings.Add(1)
 	result, err := page.Eval(`async (url) => {
 		if (typeof window.byted_acrawler === 'undefined') {
 			throw new Error('signing function not available');
@@ -167,8 +280,13 @@ func (s *Scraper) browserFetch(rawURL string) ([]byte, error) {
 	evalDur := time.Since(evalStart)
 	if err != nil {
 		s.signingReady.Store(false)
-		perfLog("browserFetch: eval FAILED after %v", evalDur)
-		return nil, fmt.Errorf("%w: %v", ErrSigningFailed, err)
+		s.logTiming(context.Background(), "browserFetch", evalDur, slog.Bool("failed", true))
+		// A crash mid-eval would otherwise only surface on the next call's
+		// ensureSigningReady; report errPageDead now so fetchAPI restarts.
+		if herr := s.browserHealthCheck(page); herr != nil {
+			return nil, fmt.Errorf("browser fetch: %w", herr)
+		}
+		return nil, s.withScreenshot(page, fmt.Errorf("%w: %v", ErrSigningFailed, err))
 	}
 
 	// Parse timing from JS result.
@@ -181,15 +299,17 @@ func (s *Scraper) browserFetch(rawURL string) ([]byte, error) {
 	}
 	if err := json.Unmarshal([]byte(raw), &jsResult); err != nil {
 		// Fallback: old format (plain text).
-		perfLog("browserFetch: eval=%v total=%v (timing parse failed)", evalDur, time.Since(totalStart))
+		s.logTiming(context.Background(), "browserFetch", time.Since(totalStart),
+			slog.Duration("eval", evalDur), slog.Bool("timing_parse_failed", true))
 		if raw == "" {
 			return nil, nil
 		}
 		return []byte(raw), nil
 	}
 
-	perfLog("browserFetch: js_sign=%dms js_fetch=%dms js_read=%dms eval=%v total=%v body=%d bytes",
-		jsResult.SignMs, jsResult.FetchMs, jsResult.ReadMs, evalDur, time.Since(totalStart), len(jsResult.Body))
+	s.logTiming(context.Background(), "browserFetch", time.Since(totalStart),
+		slog.Int("js_sign_ms", jsResult.SignMs), slog.Int("js_fetch_ms", jsResult.FetchMs),
+		slog.Int("js_read_ms", jsResult.ReadMs), slog.Duration("eval", evalDur), slog.Int("bytes", len(jsResult.Body)))
 
 	if jsResult.Body == "" {
 		return nil, nil
@@ -197,19 +317,26 @@ func (s *Scraper) browserFetch(rawURL string) ([]byte, error) {
 	return []byte(jsResult.Body), nil
 }
 
-// ensureSigningReady checks if the signing JS is available, reloading only if
-// a previous call failed (cached via atomic bool to avoid overhead per call).
-func (s *Scraper) ensureSigningReady() error {
+// ensureSigningReady checks if the signing JS is available in page, reloading
+// only if a previous call failed (cached via atomic bool to avoid overhead per
+// call). With a browser pool the flag is shared: a failure on any page makes
+// the next fetch re-check its own page. A page that does not respond at all
+// yields errPageDead, which makes fetchAPI restart the browser; browserFetch
+// runs the same check when its eval fails.
+func (s *Scraper) ensureSigningReady(page *rod.Page) error {
 	if s.signingReady.Load() {
 		return nil
 	}
+	if err := s.browserHealthCheck(page); err != nil {
+		return err
+	}
 
-	result, err := s.page.Timeout(3 * time.Second).Eval(`() => typeof window.byted_acrawler !== 'undefined'`)
+	result, err := page.Timeout(3 * time.Second).Eval(`() => typeof window.byted_acrawler !== 'undefined'`)
 	if err != nil || !result.Value.Bool() {
-		if err := s.page.Navigate(s.baseURL); err != nil {
+		if err := page.Navigate(s.baseURL); err != nil {
 			return fmt.Errorf("reload for signing: %w", err)
 		}
-		if err := s.page.WaitStable(2 * time.Second); err != nil {
+		if err := page.WaitStable(2 * time.Second); err != nil {
 			return fmt.Errorf("wait after reload: %w", err)
 		}
 	}
@@ -218,7 +345,64 @@ func (s *Scraper) ensureSigningReady() error {
 	return nil
 }
 
+// browserHealthCheck reports errPageDead if page cannot evaluate a trivial
+// script within two seconds.
+func (s *Scraper) browserHealthCheck(page *rod.Page) error {
+	if _, err := page.Timeout(2 * time.Second).Eval(`() => 1`); err != nil {
+		return fmt.Errorf("%w: %v", errPageDead, err)
+	}
+	return nil
+}
+
+// checkBrowser verifies the browser responds and returns its version string.
+func (s *Scraper) checkBrowser(ctx context.Context) (string, error) {
+	s.browserStateMu.RLock()
+	defer s.browserStateMu.RUnlock()
+	if s.browser == nil {
+		return "", fmt.Errorf("browser check: %w", ErrBrowserNotReady)
+	}
+	v, err := proto.BrowserGetVersion{}.Call(s.browser.Context(ctx))
+	if err != nil {
+		return "", fmt.Errorf("browser check: %w", err)
+	}
+	return v.Product, nil
+}
+
+// pingBrowser evaluates a trivial script in the signing page. It passes
+// when no browser has been initialized.
+func (s *Scraper) pingBrowser(ctx context.Context) error {
+	s.browserStateMu.RLock()
+	defer s.browserStateMu.RUnlock()
+	if s.page == nil {
+		return nil
+	}
+	if _, err := s.page.Context(ctx).Eval(`() => true`); err != nil {
+		return fmt.Errorf("ping browser: %w", err)
+	}
+	return nil
+}
+
+// checkSigning verifies frontierSign is callable in the page without
+// signing anything.
+func (s *Scraper) checkSigning(ctx context.Context) error {
+	s.browserStateMu.RLock()
+	defer s.browserStateMu.RUnlock()
+	if s.page == nil {
+		return fmt.Errorf("signing check: %w", ErrBrowserNotReady)
+	}
+	result, err := s.page.Context(ctx).Eval(`() => typeof window.byted_acrawler?.frontierSign === 'function'`)
+	if err != nil {
+		return fmt.Errorf("signing check: %w: %v", ErrSigningFailed, err)
+	}
+	if !result.Value.Bool() {
+		return fmt.Errorf("signing check: %w: frontierSign not available", ErrSigningFailed)
+	}
+	return nil
+}
+
 func (s *Scraper) closeBrowser() error {
+	// Pooled pages belong to s.browser and close with it.
+	s.pool = nil
 	if s.page != nil {
 		if err := s.page.Close(); err != nil {
 			return fmt.Errorf("close page: %w", err)
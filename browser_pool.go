@@ -0,0 +1,33 @@
+package tiktok
+
+import (
+	"context"
+
+	"github.com/go-rod/rod"
+)
+
+// browserPool hands out browser pages for concurrent sign+fetch calls. The
+// buffered channel is the semaphore: each page is either idle in the channel
+// or in use by exactly one fetch.
+type browserPool struct {
+	pages chan *rod.Page
+}
+
+func newBrowserPool(size int) *browserPool {
+	return &browserPool{pages: make(chan *rod.Page, size)}
+}
+
+// get blocks until a page is idle or ctx is done.
+func (p *browserPool) get(ctx context.Context) (*rod.Page, error) {
+	select {
+	case page := <-p.pages:
+		return page, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// put returns a page to the pool.
+func (p *browserPool) put(page *rod.Page) {
+	p.pages <- page
+}
@@ -0,0 +1,66 @@
+package tiktok
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// defaultMaxBrowserRestarts caps automatic browser restarts (see
+// WithMaxBrowserRestarts).
+const defaultMaxBrowserRestarts = 3
+
+// errPageDead is returned by ensureSigningReady when the page does not
+// answer a trivial eval, i.e. Chrome has crashed or the page was closed.
+var errPageDead = errors.New("tiktok: browser page not responding")
+
+// WithMaxBrowserRestarts caps how many times the Scraper relaunches a crashed
+// browser over its lifetime (default 3). Once exhausted, browser fetches fail
+// with ErrBrowserDead. Zero disables automatic restarts; negative values are
+// ignored.
+func (s *Scraper) WithMaxBrowserRestarts(n int) *Scraper {
+	if n >= 0 {
+		s.maxBrowserRestarts = n
+	}
+	return s
+}
+
+// fetchWithRecovery runs a browser fetch and, if the page turns out to be
+// dead, restarts the browser and retries once.
+func (s *Scraper) fetchWithRecovery(ctx context.Context, rawURL string) ([]byte, error) {
+	gen := s.browserGen.Load()
+	body, err := s.fetchOnPage(ctx, rawURL)
+	if !errors.Is(err, errPageDead) {
+		return body, err
+	}
+	if err := s.restartBrowser(ctx, gen); err != nil {
+		return nil, err
+	}
+	return s.fetchOnPage(ctx, rawURL)
+}
+
+// restartBrowser closes and relaunches the browser, unless another fetch
+// already restarted it since generation gen was observed.
+func (s *Scraper) restartBrowser(ctx context.Context, gen int64) error {
+	s.browserStateMu.Lock()
+	defer s.browserStateMu.Unlock()
+
+	if s.browserGen.Load() != gen {
+		return nil
+	}
+	if s.browserRestarts >= s.maxBrowserRestarts {
+		return fmt.Errorf("%w: %d restarts exhausted", ErrBrowserDead, s.browserRestarts)
+	}
+	s.browserRestarts++
+	s.logger().LogAttrs(ctx, slog.LevelWarn, "browser not responding, restarting",
+		slog.Int("restart", s.browserRestarts), slog.Int("max", s.maxBrowserRestarts))
+
+	// A crashed browser may fail to close cleanly; launch a fresh one anyway.
+	_ = s.closeBrowser()
+	if err := s.relaunchFunc(); err != nil {
+		return fmt.Errorf("%w: relaunch: %w", ErrBrowserDead, err)
+	}
+	s.browserGen.Add(1)
+	return nil
+}
@@ -2,7 +2,12 @@
 
 package tiktok
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-rod/rod"
+)
 
 func (s *Scraper) InitBrowser() error {
 	return fmt.Errorf("browser: %w (build tag: unittest)", ErrBrowserNotReady)
@@ -12,6 +17,10 @@ func (s *Scraper) launchBrowser() error {
 	return fmt.Errorf("browser: %w (build tag: unittest)", ErrBrowserNotReady)
 }
 
+func (s *Scraper) relaunchBrowser() error {
+	return s.launchBrowser()
+}
+
 func (s *Scraper) setupResourceBlocking() {}
 
 func (s *Scraper) signURL(rawURL string) (string, error) {
@@ -21,18 +30,31 @@ func (s *Scraper) signURL(rawURL string) (string, error) {
 	return "", ErrBrowserNotReady
 }
 
-func (s *Scraper) browserFetch(rawURL string) ([]byte, error) {
+func (s *Scraper) browserFetch(page *rod.Page, rawURL string) ([]byte, error) {
 	return nil, ErrBrowserNotReady
 }
 
-func (s *Scraper) ensureSigningReady() error {
+func (s *Scraper) ensureSigningReady(page *rod.Page) error {
 	if s.signingReady.Load() {
 		return nil
 	}
 	return ErrBrowserNotReady
 }
 
+func (s *Scraper) checkBrowser(ctx context.Context) (string, error) {
+	return "", fmt.Errorf("browser check: %w", ErrBrowserNotReady)
+}
+
+func (s *Scraper) pingBrowser(ctx context.Context) error {
+	return nil
+}
+
+func (s *Scraper) checkSigning(ctx context.Context) error {
+	return fmt.Errorf("signing check: %w", ErrBrowserNotReady)
+}
+
 func (s *Scraper) closeBrowser() error {
+	s.pool = nil
 	s.page = nil
 	s.browser = nil
 	return nil
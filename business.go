@@ -0,0 +1,63 @@
+package tiktok
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const businessAPIBaseURL = "https://business-api.tiktok.com"
+
+// WithBusinessAPIMode points the Scraper at TikTok's Business API, which
+// authenticates with an access token instead of session cookies. Requests
+// carry "Authorization: Bearer <token>", omit the cookie-based API params,
+// and bypass browser signing entirely. An empty token leaves the Scraper
+// unchanged.
+//
+// Compatible: methods built on browserAPIRequest (search, hashtag, sound,
+// user video and comment listings) and plain API calls such as
+// GetSuggestedHashtags, provided the Business API serves the same path.
+// Not compatible: GetUser (parses www.tiktok.com HTML), Login,
+// LoginWithCookies, and InitBrowser.
+func (s *Scraper) WithBusinessAPIMode(accessToken string) *Scraper {
+	if accessToken == "" {
+		return s
+	}
+	s.businessToken = accessToken
+	s.baseURL = businessAPIBaseURL
+	// The cookie-based params are dropped in Business mode.
+	s.resetParamsCache()
+	return s
+}
+
+// isBusinessAPIRequest reports whether req goes to the Business API host, the
+// only host that may see the access token. Other requests (e.g. subtitle
+// files on TikTok's CDN) are sent without it.
+func (s *Scraper) isBusinessAPIRequest(req *http.Request) bool {
+	if s.businessToken == "" {
+		return false
+	}
+	base, err := url.Parse(s.baseURL)
+	return err == nil && strings.EqualFold(req.URL.Host, base.Host)
+}
+
+// httpFetch GETs rawURL via the HTTP client and returns the response body.
+func (s *Scraper) httpFetch(ctx context.Context, rawURL string) ([]byte, error) {
+	resp, err := s.doRequest(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: http %d", ErrInvalidResponse, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+	return body, nil
+}
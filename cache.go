@@ -0,0 +1,143 @@
+package tiktok
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultCacheSize is the maximum number of entries kept by WithCache.
+const defaultCacheSize = 256
+
+// searchCachePaths are the API paths cached only with WithCacheSearch.
+var searchCachePaths = []string{"/api/search/", "/api/challenge/"}
+
+// WithCache keeps successful GetUser responses in memory for ttl, keyed on
+// the request URL, evicting the least recently used entry beyond 256
+// entries. Search and hashtag results are only cached after
+// WithCacheSearch(true). A ttl of zero or less disables the cache.
+func (s *Scraper) WithCache(ttl time.Duration) *Scraper {
+	if ttl <= 0 {
+		s.cache = nil
+		return s
+	}
+	s.cache = newResponseCache(ttl, defaultCacheSize)
+	return s
+}
+
+// WithCacheSearch opts search and hashtag API responses into the WithCache
+// cache. Off by default, since results change quickly. Per-request params
+// such as msToken and history_len are ignored when keying.
+func (s *Scraper) WithCacheSearch(enabled bool) *Scraper {
+	s.cacheSearch = enabled
+	return s
+}
+
+// CacheStats returns the number of cache hits and misses since WithCache was
+// called. Both are zero when the cache is disabled.
+func (s *Scraper) CacheStats() (hits, misses int) {
+	return s.cache.stats()
+}
+
+// isSearchCachePath reports whether responses for path are search results.
+func isSearchCachePath(path string) bool {
+	for _, prefix := range searchCachePaths {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// responseCache is a TTL'd LRU cache of response bodies. A nil
+// *responseCache is a valid, always-missing cache.
+type responseCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	maxSize int
+	entries map[string]*list.Element // Values are *cacheEntry.
+	lru     *list.List               // Front is most recently used.
+	hits    int
+	misses  int
+}
+
+type cacheEntry struct {
+	key     string
+	body    []byte
+	expires time.Time
+}
+
+func newResponseCache(ttl time.Duration, maxSize int) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		maxSize: maxSize,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// get returns the cached body for key if present and unexpired.
+func (c *responseCache) get(key string) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok || time.Now().After(el.Value.(*cacheEntry).expires) {
+		if ok {
+			c.remove(el)
+		}
+		c.misses++
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	c.hits++
+	return el.Value.(*cacheEntry).body, true
+}
+
+// put stores body under key, evicting the least recently used entry when
+// the cache is full.
+func (c *responseCache) put(key string, body []byte) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(el)
+	}
+	entry := &cacheEntry{key: key, body: body, expires: time.Now().Add(c.ttl)}
+	c.entries[key] = c.lru.PushFront(entry)
+	if c.lru.Len() > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+}
+
+// remove drops el. Caller must hold mu.
+func (c *responseCache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*cacheEntry).key)
+}
+
+func (c *responseCache) stats() (hits, misses int) {
+	if c == nil {
+		return 0, 0
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.hits, c.misses
+}
+
+// resetStats zeroes the hit and miss counts.
+func (c *responseCache) resetStats() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hits, c.misses = 0, 0
+}
@@ -0,0 +1,101 @@
+package tiktok
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// statusCaptcha is the API status code TikTok returns when a request must
+// pass a captcha before it is served.
+const statusCaptcha = 10112
+
+// CaptchaSolver solves a TikTok captcha challenge, e.g. through a third-party
+// solving service. captchaURL is the request that was challenged and siteKey
+// the captcha's site key, if TikTok sent one. The returned token is sent
+// back as the captcha_token param of the retried request.
+type CaptchaSolver interface {
+	Solve(ctx context.Context, captchaURL, siteKey string) (token string, err error)
+}
+
+// NopCaptchaSolver is a CaptchaSolver that never solves anything: Solve
+// always returns ErrCaptcha. Useful in tests.
+type NopCaptchaSolver struct{}
+
+// Solve implements CaptchaSolver.
+func (NopCaptchaSolver) Solve(context.Context, string, string) (string, error) {
+	return "", ErrCaptcha
+}
+
+// WithCaptchaSolver sets the solver used when an API request is challenged
+// with a captcha. The request is retried once with the solver's token.
+// Without a solver, challenged requests fail with ErrCaptcha.
+func (s *Scraper) WithCaptchaSolver(solver CaptchaSolver) *Scraper {
+	s.captchaSolver = solver
+	return s
+}
+
+// captchaError is returned for a captcha-challenged response. It wraps
+// ErrCaptcha and carries what the solver needs.
+type captchaError struct {
+	url     string
+	siteKey string
+}
+
+func (e *captchaError) Error() string { return ErrCaptcha.Error() }
+func (e *captchaError) Unwrap() error { return ErrCaptcha }
+
+var siteKeyRe = regexp.MustCompile(`data-sitekey="([^"]+)"`)
+
+// detectCaptcha returns a *captchaError if body is a captcha challenge:
+// either JSON with status 10112 or a captcha HTML page.
+func detectCaptcha(rawURL string, body []byte) error {
+	if len(body) == 0 {
+		return nil
+	}
+	if body[0] == '<' {
+		if !bytes.Contains(bytes.ToLower(body), []byte("captcha")) {
+			return nil
+		}
+		ce := &captchaError{url: rawURL}
+		if m := siteKeyRe.FindSubmatch(body); m != nil {
+			ce.siteKey = string(m[1])
+		}
+		return ce
+	}
+	// Cheap pre-check so ordinary responses are not decoded twice.
+	if !bytes.Contains(body, []byte("10112")) {
+		return nil
+	}
+	var status struct {
+		StatusCode      int `json:"status_code"`
+		StatusCodeCamel int `json:"statusCode"`
+	}
+	if json.Unmarshal(body, &status) != nil {
+		return nil
+	}
+	if status.StatusCode == statusCaptcha || status.StatusCodeCamel == statusCaptcha {
+		return &captchaError{url: rawURL}
+	}
+	return nil
+}
+
+// solveCaptcha asks the configured solver for a token and returns rawURL
+// with the token added as captcha_token.
+func (s *Scraper) solveCaptcha(ctx context.Context, rawURL string, ce *captchaError) (string, error) {
+	token, err := s.captchaSolver.Solve(ctx, ce.url, ce.siteKey)
+	if err != nil {
+		return "", fmt.Errorf("solve captcha: %w", err)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("solve captcha: %w", err)
+	}
+	q := u.Query()
+	q.Set("captcha_token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
@@ -1,115 +1,385 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
+	"path/filepath"
 	"time"
 
 	tiktok "github.com/RavensCloud/tiktok-gofun"
 )
 
+const usage = "usage: tiktok --user <username> | --search <keyword> | --hashtag <tag> | --live | --trending | --login --user <user> --pass <pass> | --proxy <url> --proxy-test | --validate-cookies <path>"
+
 func main() {
-	user := flag.String("user", "", "TikTok username to look up")
-	search := flag.String("search", "", "Search videos by keyword")
-	hashtag := flag.String("hashtag", "", "Search videos by hashtag")
-	limit := flag.Int("limit", 10, "Max results to return")
-	cookies := flag.String("cookies", "", "Path to cookies JSON file")
-	proxyURL := flag.String("proxy", "", "Proxy URL (http/https/socks5)")
-	login := flag.Bool("login", false, "Login with --user and --pass, then save cookies")
-	pass := flag.String("pass", "", "TikTok password (used with --login)")
-	saveCookies := flag.String("save-cookies", "cookies.json", "Path to save cookies after login")
-	debug := flag.Bool("debug", false, "Enable performance timing output")
-	flag.Parse()
-
-	if *user == "" && *search == "" && *hashtag == "" && !*login {
-		fmt.Fprintln(os.Stderr, "usage: tiktok --user <username> | --search <keyword> | --hashtag <tag> | --login --user <user> --pass <pass>")
+	f, err := parseFlags(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 
-	s := tiktok.New()
-	defer s.Close()
+	// Cookie validation is offline and needs no Scraper.
+	if f.validateCookies != "" {
+		os.Exit(runValidateCookies(f.validateCookies))
+	}
 
-	if *debug {
-		s.SetDebug(true)
+	if err := f.validate(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 
-	if *proxyURL != "" {
-		if err := s.SetProxy(*proxyURL); err != nil {
-			log.Fatalf("set proxy: %v", err)
-		}
+	s, err := newScraper(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = run(context.Background(), s, f)
+	s.Close()
+	if err != nil {
+		log.Fatal(err)
 	}
+}
 
-	ctx := context.Background()
+// cliFlags holds the parsed command-line flags.
+type cliFlags struct {
+	user, search, hashtag   string
+	live, trending          bool
+	limit                   int
+	cookies                 string
+	proxyURL                string
+	proxyTest, noVerifyTLS  bool
+	login                   bool
+	pass, saveCookies       string
+	debug, headful          bool
+	format                  string
+	replayCache             string
+	validateCookies, config string
+}
 
-	// Login mode: authenticate and save cookies.
-	if *login {
-		if *user == "" || *pass == "" {
-			log.Fatal("--login requires --user and --pass")
-		}
-		if err := s.InitBrowser(); err != nil {
-			log.Fatalf("init browser: %v", err)
+// parseFlags parses args into a cliFlags, then fills the flags not given
+// explicitly from the config file.
+func parseFlags(fs *flag.FlagSet, args []string) (*cliFlags, error) {
+	f := &cliFlags{}
+	fs.StringVar(&f.user, "user", "", "TikTok username to look up")
+	fs.StringVar(&f.search, "search", "", "Search videos by keyword")
+	fs.StringVar(&f.hashtag, "hashtag", "", "Search videos by hashtag")
+	fs.BoolVar(&f.live, "live", false, "List recommended live streams")
+	fs.BoolVar(&f.trending, "trending", false, "List trending videos from the discovery feed")
+	fs.IntVar(&f.limit, "limit", 10, "Max results to return")
+	fs.StringVar(&f.cookies, "cookies", "", "Path to cookies JSON file")
+	fs.StringVar(&f.proxyURL, "proxy", "", "Proxy URL: http://host:port, https://host:port, socks5://[user:pass@]host:port or socks4://[userid@]host:port")
+	fs.BoolVar(&f.proxyTest, "proxy-test", false, "Make a test request through --proxy and report latency")
+	fs.BoolVar(&f.noVerifyTLS, "no-verify-proxy-tls", false, "Skip TLS verification (for proxies with self-signed certificates)")
+	fs.BoolVar(&f.login, "login", false, "Login with --user and --pass, then save cookies")
+	fs.StringVar(&f.pass, "pass", "", "TikTok password (used with --login)")
+	fs.StringVar(&f.saveCookies, "save-cookies", "cookies.json", "Path to save cookies after login")
+	fs.BoolVar(&f.debug, "debug", false, "Enable performance timing output")
+	fs.BoolVar(&f.headful, "headful", false, "Show the browser window (debugging only)")
+	fs.StringVar(&f.format, "format", "table", "Output format: table, json or csv")
+	fs.StringVar(&f.replayCache, "replay-cache", "", "Record HTTP responses to this dir and replay them on later runs (offline testing)")
+	fs.StringVar(&f.validateCookies, "validate-cookies", "", "Check a cookies JSON file offline and print a report")
+	fs.StringVar(&f.config, "config", "", "JSON config file with flag defaults (default $HOME/.tiktok.json if present)")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if path := resolveConfigPath(f.config); path != "" {
+		if err := applyConfig(fs, path); err != nil {
+			return nil, fmt.Errorf("config: %w", err)
 		}
-		fmt.Println("Logging in...")
-		if err := s.Login(*user, *pass); err != nil {
-			log.Fatalf("login: %v", err)
+	}
+	return f, nil
+}
+
+// mode returns the operation the flags select, in precedence order, or ""
+// if none is set (a bare --proxy-test).
+func (f *cliFlags) mode() string {
+	switch {
+	case f.login:
+		return "login"
+	case f.search != "":
+		return "search"
+	case f.hashtag != "":
+		return "hashtag"
+	case f.live:
+		return "live"
+	case f.trending:
+		return "trending"
+	case f.user != "":
+		return "user"
+	}
+	return ""
+}
+
+// validate checks flag combinations and normalizes --format.
+func (f *cliFlags) validate() error {
+	if f.mode() == "" && !f.proxyTest {
+		return errors.New(usage)
+	}
+	switch f.format {
+	case "table", "json", "csv":
+	case "text": // Former name of the table format.
+		f.format = "table"
+	default:
+		return fmt.Errorf("invalid --format %q (want table, json or csv)", f.format)
+	}
+	if f.mode() == "live" && f.format == "csv" {
+		return errors.New("--live does not support --format csv (want table or json)")
+	}
+	if f.proxyTest && f.proxyURL == "" {
+		return errors.New("--proxy-test requires --proxy")
+	}
+	if f.proxyURL != "" {
+		if err := validateProxyURL(f.proxyURL); err != nil {
+			return fmt.Errorf("invalid --proxy: %w", err)
 		}
-		if err := s.SaveCookies(*saveCookies); err != nil {
-			log.Fatalf("save cookies: %v", err)
+	}
+	return nil
+}
+
+// newScraper returns a Scraper configured from the flags.
+func newScraper(f *cliFlags) (*tiktok.Scraper, error) {
+	s := tiktok.New()
+	if f.debug {
+		s.SetDebug(true)
+	}
+	if f.replayCache != "" {
+		s.WithHTTPCacheDir(f.replayCache)
+	}
+	if f.headful {
+		s.WithHeadful(true)
+	}
+	if f.noVerifyTLS {
+		s.WithInsecureTLS(true)
+	}
+	if f.proxyURL != "" {
+		if err := s.SetProxy(f.proxyURL); err != nil {
+			return nil, fmt.Errorf("set proxy: %w", err)
 		}
-		fmt.Printf("Logged in! Cookies saved to %s\n", *saveCookies)
-		return
 	}
+	return s, nil
+}
 
-	// User profile lookup (pure HTTP, no browser needed).
-	if *user != "" && *search == "" && *hashtag == "" {
-		start := time.Now()
-		author, err := s.GetUser(ctx, *user)
+// run performs the proxy test, if requested, and then the selected mode.
+func run(ctx context.Context, s *tiktok.Scraper, f *cliFlags) error {
+	if f.proxyTest {
+		latency, err := s.CheckConnection(ctx)
 		if err != nil {
-			log.Fatalf("get user: %v", err)
+			return fmt.Errorf("proxy test failed: %w", err)
 		}
-		cliLog(*debug, "GetUser: %v", time.Since(start))
-		printAuthor(author)
-		return
+		fmt.Printf("Proxy OK: tiktok.com responded in %v\n", latency.Round(time.Millisecond))
+	}
+
+	switch f.mode() {
+	case "":
+		return nil
+	case "login":
+		return runLogin(s, f)
+	case "user":
+		return runUser(ctx, s, f)
+	}
+	return runListing(ctx, s, f)
+}
+
+// runLogin authenticates with --user and --pass and saves the cookies.
+func runLogin(s *tiktok.Scraper, f *cliFlags) error {
+	if f.user == "" || f.pass == "" {
+		return errors.New("--login requires --user and --pass")
+	}
+	if err := s.InitBrowser(); err != nil {
+		return fmt.Errorf("init browser: %w", err)
+	}
+	fmt.Println("Logging in...")
+	if err := s.Login(f.user, f.pass); err != nil {
+		return fmt.Errorf("login: %w", err)
 	}
+	if err := s.SaveCookies(f.saveCookies); err != nil {
+		return fmt.Errorf("save cookies: %w", err)
+	}
+	fmt.Printf("Logged in! Cookies saved to %s\n", f.saveCookies)
+	return nil
+}
 
-	// Search and hashtag require browser + cookies.
+// runUser prints a profile (pure HTTP, no browser needed).
+func runUser(ctx context.Context, s *tiktok.Scraper, f *cliFlags) error {
+	start := time.Now()
+	author, err := s.GetUser(ctx, f.user)
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+	cliLog(f.debug, "GetUser: %v", time.Since(start))
+	outputAuthor(author, f.format)
+	return nil
+}
+
+// runListing starts the browser, which search, hashtag, live and trending
+// listings require, and prints the selected listing.
+func runListing(ctx context.Context, s *tiktok.Scraper, f *cliFlags) error {
 	start := time.Now()
 	if err := s.InitBrowser(); err != nil {
-		log.Fatalf("init browser: %v", err)
+		return fmt.Errorf("init browser: %w", err)
 	}
-	cliLog(*debug, "InitBrowser: %v", time.Since(start))
+	cliLog(f.debug, "InitBrowser: %v", time.Since(start))
 
-	if *cookies != "" {
+	if f.cookies != "" {
 		start = time.Now()
-		if err := s.LoginWithCookies(*cookies); err != nil {
-			log.Fatalf("login with cookies: %v", err)
+		if err := s.LoginWithCookies(f.cookies); err != nil {
+			return fmt.Errorf("login with cookies: %w", err)
 		}
-		cliLog(*debug, "LoginWithCookies: %v", time.Since(start))
+		cliLog(f.debug, "LoginWithCookies: %v", time.Since(start))
 	}
 
-	if *search != "" {
+	if f.mode() == "live" {
 		start = time.Now()
-		videos, err := s.SearchVideos(ctx, *search, *limit)
+		streams, err := s.GetLiveStreams(ctx, f.limit)
 		if err != nil {
-			log.Fatalf("search: %v", err)
+			return fmt.Errorf("live streams: %w", err)
 		}
-		cliLog(*debug, "SearchVideos: %v", time.Since(start))
-		printVideos(videos)
-		return
+		cliLog(f.debug, "GetLiveStreams: %v", time.Since(start))
+		outputLiveStreams(streams, f.format)
+		return nil
 	}
+	return runVideos(ctx, s, f)
+}
 
-	if *hashtag != "" {
-		start = time.Now()
-		videos, err := s.SearchByHashtag(ctx, *hashtag, *limit)
-		if err != nil {
-			log.Fatalf("hashtag search: %v", err)
+// runVideos prints the search, hashtag or trending video listing.
+func runVideos(ctx context.Context, s *tiktok.Scraper, f *cliFlags) error {
+	start := time.Now()
+	var videos []tiktok.Video
+	var op, label string
+	var err error
+	switch f.mode() {
+	case "search":
+		op, label = "SearchVideos", "search"
+		videos, err = s.SearchVideos(ctx, f.search, f.limit)
+	case "hashtag":
+		op, label = "SearchByHashtag", "hashtag search"
+		videos, err = s.SearchByHashtag(ctx, f.hashtag, f.limit)
+	default:
+		op, label = "GetTrendingVideos", "trending"
+		videos, err = s.GetTrendingVideos(ctx, f.limit)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", label, err)
+	}
+	cliLog(f.debug, "%s: %v", op, time.Since(start))
+	outputVideos(videos, f.format)
+	return nil
+}
+
+// cliConfig is the --config file format. Keys mirror the flag names; flags
+// given on the command line take precedence.
+type cliConfig struct {
+	Cookies          *string `json:"cookies,omitempty"`
+	Proxy            *string `json:"proxy,omitempty"`
+	NoVerifyProxyTLS *bool   `json:"no-verify-proxy-tls,omitempty"`
+	Limit            *int    `json:"limit,omitempty"`
+	Format           *string `json:"format,omitempty"`
+	SaveCookies      *string `json:"save-cookies,omitempty"`
+	ReplayCache      *string `json:"replay-cache,omitempty"`
+	Debug            *bool   `json:"debug,omitempty"`
+}
+
+// resolveConfigPath returns the --config path, or $HOME/.tiktok.json if that
+// file exists, or "" for no config.
+func resolveConfigPath(flagValue string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	path := filepath.Join(home, ".tiktok.json")
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// applyConfig loads the JSON config at path and sets each flag it names that
+// was not given explicitly in fs.
+func applyConfig(fs *flag.FlagSet, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var cfg cliConfig
+	if err := dec.Decode(&cfg); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	// Round-trip through a map to address the set fields by flag name.
+	set, _ := json.Marshal(cfg)
+	var values map[string]any
+	_ = json.Unmarshal(set, &values)
+
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+	for name, v := range values {
+		if explicit[name] {
+			continue
+		}
+		if err := fs.Set(name, fmt.Sprint(v)); err != nil {
+			return fmt.Errorf("%s: %q: %w", path, name, err)
 		}
-		cliLog(*debug, "SearchByHashtag: %v", time.Since(start))
-		printVideos(videos)
 	}
+	return nil
+}
+
+// validateProxyURL checks the proxy URL has a scheme and host the library supports.
+func validateProxyURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("parse %q: %w", raw, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks4":
+	default:
+		return fmt.Errorf("unsupported scheme %q (want http, https, socks5 or socks4)", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("missing host in %q (e.g. socks5://proxy:1080)", raw)
+	}
+	return nil
+}
+
+// runValidateCookies prints a cookie file report and returns the exit code:
+// 0 if valid, 1 otherwise.
+func runValidateCookies(path string) int {
+	res, err := tiktok.ValidateCookieFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "validate cookies: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("File:       %s\n", path)
+	fmt.Printf("Cookies:    %d\n", res.CookieCount)
+	fmt.Printf("sessionid:  %v\n", res.HasSessionID)
+	fmt.Printf("msToken:    %v\n", res.HasMsToken)
+	if res.ExpiresAt.IsZero() {
+		fmt.Println("Expires:    unknown (session cookies)")
+	} else {
+		fmt.Printf("Expires:    %s\n", res.ExpiresAt.Format(time.RFC3339))
+	}
+	for _, e := range res.Errors {
+		fmt.Printf("Error:      %s\n", e)
+	}
+
+	if !res.Valid {
+		fmt.Println("Result:     INVALID")
+		return 1
+	}
+	fmt.Println("Result:     OK")
+	return 0
 }
 
 func cliLog(enabled bool, format string, args ...any) {
@@ -132,10 +402,74 @@ func printAuthor(a tiktok.Author) {
 	fmt.Printf("Avatar:     %s\n", a.AvatarURL)
 }
 
+// outputAuthor writes a profile to stdout in the given --format.
+func outputAuthor(a tiktok.Author, format string) {
+	switch format {
+	case "json":
+		checkOutput(writeJSON(a))
+	case "csv":
+		checkOutput(tiktok.WriteUsersCSV(os.Stdout, []tiktok.Author{a}))
+	default:
+		printAuthor(a)
+	}
+}
+
+// outputVideos writes videos to stdout in the given --format.
+func outputVideos(videos []tiktok.Video, format string) {
+	switch format {
+	case "json":
+		checkOutput(writeJSON(tiktok.VideoList(videos)))
+	case "csv":
+		checkOutput(tiktok.WriteVideosCSV(os.Stdout, videos))
+	default:
+		printVideos(videos)
+	}
+}
+
+// outputLiveStreams writes live streams to stdout as JSON, or as a table for
+// any other --format.
+func outputLiveStreams(streams []tiktok.LiveStream, format string) {
+	if format == "json" {
+		checkOutput(writeJSON(streams))
+		return
+	}
+	printLiveStreams(streams)
+}
+
+func printLiveStreams(streams []tiktok.LiveStream) {
+	for i, ls := range streams {
+		fmt.Printf("[%d] @%s — %d viewers, live since %s\n",
+			i+1, ls.Username, ls.ViewerCount, ls.StartedAt.Format("15:04"),
+		)
+		if ls.Title != "" {
+			fmt.Printf("    %s\n", ls.Title)
+		}
+		fmt.Printf("    %s\n", ls.ShareURL)
+	}
+	fmt.Printf("\nTotal: %d live streams\n", len(streams))
+}
+
+// writeJSON writes v to stdout as indented JSON.
+func writeJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func checkOutput(err error) {
+	if err != nil {
+		log.Fatalf("write output: %v", err)
+	}
+}
+
 func printVideos(videos []tiktok.Video) {
 	for i, v := range videos {
-		fmt.Printf("[%d] %s by @%s — %d views, %d likes (%s)\n",
-			i+1, v.ID, v.Username, v.Views, v.Likes,
+		badge := ""
+		if v.AuthorVerified {
+			badge = " ✓"
+		}
+		fmt.Printf("[%d] %s by @%s%s — %d views, %d likes (%s)\n",
+			i+1, v.ID, v.Username, badge, v.Views, v.Likes,
 			v.CreatedAt.Format("2006-01-02"),
 		)
 		if v.Description != "" {
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	tiktok "github.com/RavensCloud/tiktok-gofun"
+)
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns what
+// it wrote. Tests using it must not run in parallel.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	orig := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	done := make(chan string)
+	go func() {
+		b, _ := io.ReadAll(r)
+		done <- string(b)
+	}()
+
+	fn()
+	w.Close()
+	return <-done
+}
+
+var testVideos = []tiktok.Video{
+	{ID: "1", Username: "creator", Description: "first", Views: 100, Likes: 10, CreatedAt: time.Unix(1706000000, 0)},
+	{ID: "2", Username: "creator", Views: 200, Likes: 20, CreatedAt: time.Unix(1706000100, 0)},
+}
+
+func TestOutputVideos_JSON(t *testing.T) {
+	out := captureStdout(t, func() { outputVideos(testVideos, "json") })
+
+	var got struct {
+		Videos []tiktok.Video `json:"videos"`
+		Count  int            `json:"count"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, out)
+	}
+	if got.Count != 2 || len(got.Videos) != 2 || got.Videos[0].ID != "1" || got.Videos[1].Views != 200 {
+		t.Errorf("unexpected videos %+v", got)
+	}
+	if !strings.Contains(out, `"created_at": 1706000000`) {
+		t.Errorf("expected snake_case JSON keys, got:\n%s", out)
+	}
+}
+
+func TestOutputVideos_CSV(t *testing.T) {
+	out := captureStdout(t, func() { outputVideos(testVideos, "csv") })
+
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	if len(records) != 3 || records[1][0] != "1" {
+		t.Errorf("expected header + 2 rows, got %v", records)
+	}
+}
+
+func TestOutputVideos_Table(t *testing.T) {
+	out := captureStdout(t, func() { outputVideos(testVideos, "table") })
+
+	if !strings.Contains(out, "[1] 1 by @creator — 100 views, 10 likes") || !strings.Contains(out, "Total: 2 videos") {
+		t.Errorf("unexpected table output:\n%s", out)
+	}
+}
+
+func TestOutputAuthor(t *testing.T) {
+	author := tiktok.Author{ID: "9", Username: "creator", FollowerCount: 1000}
+
+	out := captureStdout(t, func() { outputAuthor(author, "json") })
+	var got tiktok.Author
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, out)
+	}
+	if !reflect.DeepEqual(got, author) {
+		t.Errorf("got %+v, want %+v", got, author)
+	}
+
+	out = captureStdout(t, func() { outputAuthor(author, "table") })
+	if !strings.Contains(out, "User:       creator") || !strings.Contains(out, "Followers:  1000") {
+		t.Errorf("unexpected table output:\n%s", out)
+	}
+}
+
+func TestOutputLiveStreams_Table(t *testing.T) {
+	streams := []tiktok.LiveStream{{
+		ID: "7", Title: "late night", Username: "host", ViewerCount: 321,
+		StartedAt: time.Date(2024, 1, 23, 21, 5, 0, 0, time.Local), ShareURL: "https://www.tiktok.com/@host/live",
+	}}
+	out := captureStdout(t, func() { outputLiveStreams(streams, "table") })
+
+	for _, want := range []string{"[1] @host — 321 viewers, live since 21:05", "late night", "@host/live", "Total: 1 live streams"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"cookies": "c.json", "limit": 50, "format": "csv", "debug": true}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	cookies := fs.String("cookies", "", "")
+	limit := fs.Int("limit", 10, "")
+	format := fs.String("format", "table", "")
+	debug := fs.Bool("debug", false, "")
+	if err := fs.Parse([]string{"--format", "json"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := applyConfig(fs, path); err != nil {
+		t.Fatalf("applyConfig: %v", err)
+	}
+	if *cookies != "c.json" || *limit != 50 || !*debug {
+		t.Errorf("config not applied: cookies=%q limit=%d debug=%v", *cookies, *limit, *debug)
+	}
+	if *format != "json" {
+		t.Errorf("flag should override config, format=%q", *format)
+	}
+}
+
+func TestApplyConfig_UnknownKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"cookie": "c.json"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyConfig(flag.NewFlagSet("test", flag.ContinueOnError), path); err == nil {
+		t.Error("expected error for unknown key")
+	}
+}
+
+func TestResolveConfigPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if got := resolveConfigPath(""); got != "" {
+		t.Errorf("no config file: got %q", got)
+	}
+	if got := resolveConfigPath("explicit.json"); got != "explicit.json" {
+		t.Errorf("explicit path: got %q", got)
+	}
+
+	fallback := filepath.Join(home, ".tiktok.json")
+	if err := os.WriteFile(fallback, []byte(`{}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got := resolveConfigPath(""); got != fallback {
+		t.Errorf("fallback: got %q, want %q", got, fallback)
+	}
+}
+
+func TestValidateProxyURL(t *testing.T) {
+	for _, raw := range []string{"http://p:8080", "https://p:8443", "socks5://u:pw@p:1080", "socks4://p:1080", "socks4://id@p:1080"} {
+		if err := validateProxyURL(raw); err != nil {
+			t.Errorf("validateProxyURL(%q): %v", raw, err)
+		}
+	}
+	for _, raw := range []string{"ftp://p:21", "socks4://", "p:8080"} {
+		if err := validateProxyURL(raw); err == nil {
+			t.Errorf("validateProxyURL(%q): expected error", raw)
+		}
+	}
+}
+
+func TestCLIFlags_Validate(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // No ~/.tiktok.json.
+	tests := []struct {
+		args    []string
+		wantErr string
+	}{
+		{[]string{"--user", "tiktok"}, ""},
+		{[]string{"--proxy", "socks4://p:1080", "--proxy-test"}, ""},
+		{[]string{"--live", "--format", "json"}, ""},
+		{[]string{}, "usage"},
+		{[]string{"--proxy-test"}, "--proxy-test requires --proxy"},
+		{[]string{"--live", "--format", "csv"}, "--live does not support --format csv"},
+		{[]string{"--user", "tiktok", "--format", "xml"}, "invalid --format"},
+		{[]string{"--user", "tiktok", "--proxy", "ftp://p"}, "invalid --proxy"},
+	}
+	for _, tt := range tests {
+		f, err := parseFlags(flag.NewFlagSet("test", flag.ContinueOnError), tt.args)
+		if err != nil {
+			t.Fatalf("parseFlags(%q): %v", tt.args, err)
+		}
+		err = f.validate()
+		if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%q: validate() = %v, want error containing %q", tt.args, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCLIFlags_Mode(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--user", "tiktok"}, "user"},
+		{[]string{"--user", "tiktok", "--search", "bonk"}, "search"},
+		{[]string{"--login", "--user", "me", "--pass", "pw"}, "login"},
+		{[]string{"--trending", "--live"}, "live"},
+		{[]string{"--proxy", "http://p:8080", "--proxy-test"}, ""},
+	}
+	for _, tt := range tests {
+		f, err := parseFlags(flag.NewFlagSet("test", flag.ContinueOnError), tt.args)
+		if err != nil {
+			t.Fatalf("parseFlags(%q): %v", tt.args, err)
+		}
+		if got := f.mode(); got != tt.want {
+			t.Errorf("%q: mode() = %q, want %q", tt.args, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GetVideoComments returns up to limit top-level comments on a video. Returns
+// ErrCommentsDisabled when the creator has turned comments off. Requires an
+// initialized browser.
+func (s *Scraper) GetVideoComments(ctx context.Context, videoID string, limit int) ([]Comment, error) {
+	if videoID == "" {
+		return nil, fmt.Errorf("get video comments: video id is required")
+	}
+
+	var all []Comment
+	var cursor Cursor
+
+	for len(all) < limit {
+		if err := s.waitForSearch(ctx); err != nil {
+			return all, fmt.Errorf("get video comments %q: %w", videoID, err)
+		}
+
+		comments, nextCursor, err := s.fetchVideoComments(ctx, videoID, cursor)
+		if err != nil {
+			return all, fmt.Errorf("get video comments %q: %w", videoID, err)
+		}
+		all = append(all, comments...)
+		if nextCursor.IsZero() {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(all) > limit {
+		all = all[:limit]
+	}
+	return all, nil
+}
+
+func (s *Scraper) fetchVideoComments(ctx context.Context, videoID string, cursor Cursor) ([]Comment, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/comment/list/", func(p map[string]string) {
+		p["aweme_id"] = videoID
+		p["count"] = "20"
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("video comments: %w", err)
+	}
+
+	var result commentListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode video comments: %w", err)
+	}
+	if result.StatusCode == statusCommentsDisabled {
+		return nil, Cursor{}, ErrCommentsDisabled
+	}
+
+	comments := make([]Comment, 0, len(result.Comments))
+	for _, raw := range result.Comments {
+		comments = append(comments, parseComment(raw))
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = result.Cursor
+	}
+	return comments, nextCursor, nil
+}
+
+// GetUserComments returns up to limit comments posted by the user identified
+// by secUID, newest first. Requires authentication; returns ErrPrivateAccount
+// when the user hides their comment activity.
+func (s *Scraper) GetUserComments(ctx context.Context, secUID string, limit int) ([]UserComment, error) {
+	if secUID == "" {
+		return nil, fmt.Errorf("get user comments: sec uid is required")
+	}
+	if !s.isLogged {
+		return nil, fmt.Errorf("get user comments: %w", ErrAuthRequired)
+	}
+
+	var all []UserComment
+	var cursor Cursor
+
+	for len(all) < limit {
+		if err := s.waitForSearch(ctx); err != nil {
+			return all, fmt.Errorf("get user comments %q: %w", secUID, err)
+		}
+
+		comments, nextCursor, err := s.fetchUserComments(ctx, secUID, cursor)
+		if err != nil {
+			return all, fmt.Errorf("get user comments %q: %w", secUID, err)
+		}
+		all = append(all, comments...)
+		if nextCursor.IsZero() {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(all) > limit {
+		all = all[:limit]
+	}
+	return all, nil
+}
+
+func (s *Scraper) fetchUserComments(ctx context.Context, secUID string, cursor Cursor) ([]UserComment, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/user/comment/list/", func(p map[string]string) {
+		p["secUid"] = secUID
+		p["count"] = "20"
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("user comments: %w", err)
+	}
+
+	var result rawUserCommentResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode user comments: %w", err)
+	}
+	if result.StatusCode == statusPrivateAccount {
+		return nil, Cursor{}, ErrPrivateAccount
+	}
+
+	comments := make([]UserComment, 0, len(result.Comments))
+	for _, raw := range result.Comments {
+		comments = append(comments, UserComment{
+			Text:        raw.Text,
+			VideoID:     raw.AwemeID,
+			VideoAuthor: raw.AwemeAuthor.UniqueID,
+			CreatedAt:   time.Unix(raw.CreateTime, 0),
+		})
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = result.Cursor
+	}
+	return comments, nextCursor, nil
+}
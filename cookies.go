@@ -0,0 +1,187 @@
+package tiktok
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+)
+
+// criticalCookies are the cookies a usable TikTok session file must contain.
+var criticalCookies = []string{"sessionid", "msToken", "tt_webid"}
+
+// authCookies are the cookies that carry the logged-in session. The session
+// is usable while any of them is unexpired.
+var authCookies = []string{"sessionid", "tiktokCookie"}
+
+// CookieValidationResult reports on a cookie file checked by ValidateCookieFile.
+type CookieValidationResult struct {
+	Valid        bool
+	CookieCount  int
+	HasSessionID bool
+	HasMsToken   bool
+	ExpiresAt    time.Time // Earliest expiry among critical cookies; zero if none set one.
+	Errors       []string
+}
+
+// ValidateCookieFile checks a cookie file written by SaveCookies without
+// creating a Scraper or making network calls. It reports missing or expired
+// critical cookies (sessionid, msToken, tt_webid). The error is non-nil only
+// when the file cannot be read or parsed.
+func ValidateCookieFile(path string) (CookieValidationResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return CookieValidationResult{}, fmt.Errorf("read cookies file: %w", err)
+	}
+	var cookies []*http.Cookie
+	if err := json.Unmarshal(data, &cookies); err != nil {
+		return CookieValidationResult{}, fmt.Errorf("unmarshal cookies: %w", err)
+	}
+	return validateCookies(cookies, time.Now()), nil
+}
+
+func validateCookies(cookies []*http.Cookie, now time.Time) CookieValidationResult {
+	byName := make(map[string]*http.Cookie, len(cookies))
+	for _, c := range cookies {
+		byName[c.Name] = c
+	}
+
+	res := CookieValidationResult{CookieCount: len(cookies)}
+	for _, name := range criticalCookies {
+		c, ok := byName[name]
+		if !ok || c.Value == "" {
+			res.Errors = append(res.Errors, fmt.Sprintf("missing %s cookie", name))
+			continue
+		}
+		if c.Expires.IsZero() {
+			continue
+		}
+		if c.Expires.Before(now) {
+			res.Errors = append(res.Errors, fmt.Sprintf("%s cookie expired at %s", name, c.Expires.Format(time.RFC3339)))
+		}
+		if res.ExpiresAt.IsZero() || c.Expires.Before(res.ExpiresAt) {
+			res.ExpiresAt = c.Expires
+		}
+	}
+
+	res.HasSessionID = byName["sessionid"] != nil && byName["sessionid"].Value != ""
+	res.HasMsToken = byName["msToken"] != nil && byName["msToken"].Value != ""
+	res.Valid = len(res.Errors) == 0
+	return res
+}
+
+// authCookiesExpired reports whether cookies contain at least one auth cookie
+// and every one of them has expired. Cookies without an expiry (browser
+// session cookies) never count as expired.
+func authCookiesExpired(cookies []*http.Cookie, now time.Time) bool {
+	found := false
+	for _, c := range cookies {
+		if !slices.Contains(authCookies, c.Name) {
+			continue
+		}
+		found = true
+		if c.Expires.IsZero() || c.Expires.After(now) {
+			return false
+		}
+	}
+	return found
+}
+
+func (s *Scraper) recordAuthCookieExpiry(c *http.Cookie) {
+	s.authCookieMu.Lock()
+	defer s.authCookieMu.Unlock()
+	if s.authCookieExpires == nil {
+		s.authCookieExpires = make(map[string]time.Time, len(authCookies))
+	}
+	if c.Expires.IsZero() {
+		delete(s.authCookieExpires, c.Name)
+		return
+	}
+	s.authCookieExpires[c.Name] = c.Expires
+}
+
+// authCookieExpiry returns the recorded expiry of auth cookie name, or the
+// zero time if unknown.
+func (s *Scraper) authCookieExpiry(name string) time.Time {
+	s.authCookieMu.RLock()
+	defer s.authCookieMu.RUnlock()
+	return s.authCookieExpires[name]
+}
+
+// AreCookiesValid reports whether the cookie jar holds an unexpired auth
+// cookie (sessionid or tiktokCookie). It does not make network requests, so
+// a session revoked server-side still reports as valid.
+func (s *Scraper) AreCookiesValid() bool {
+	for _, c := range s.GetCookies() {
+		if slices.Contains(authCookies, c.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// CookiesExpireAt returns when the last unexpired auth cookie in the jar
+// expires. ok is false when the jar holds no auth cookie with a known expiry.
+func (s *Scraper) CookiesExpireAt() (expires time.Time, ok bool) {
+	for _, c := range s.GetCookies() {
+		if exp := s.authCookieExpiry(c.Name); exp.After(expires) {
+			expires = exp
+		}
+	}
+	return expires, !expires.IsZero()
+}
+
+// ValidateCookies checks with TikTok that the session is still accepted,
+// using one lightweight /api/user/detail/ request over the HTTP client (no
+// browser or SSR parsing). Returns ErrCookiesExpired when the session has
+// expired (also matching ErrSessionExpired if TikTok rejected it, as the
+// listings report), ErrAuthRequired when there is no session, or nil.
+func (s *Scraper) ValidateCookies(ctx context.Context) error {
+	if !s.AreCookiesValid() {
+		// The jar drops expired cookies, so a loaded session that is now
+		// gone has expired.
+		if s.isLogged {
+			return fmt.Errorf("validate cookies: %w", ErrCookiesExpired)
+		}
+		return fmt.Errorf("validate cookies: %w", ErrAuthRequired)
+	}
+
+	params := s.buildAPIParams()
+	params.Set("uniqueId", "tiktok")
+	resp, err := s.doRequest(ctx, "GET", s.baseURL+"/api/user/detail/?"+params.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("validate cookies: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("validate cookies: %w: http %d", ErrInvalidResponse, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("validate cookies: read body: %w", err)
+	}
+	var result struct {
+		StatusCode      int `json:"statusCode"`
+		StatusCodeSnake int `json:"status_code"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("validate cookies: decode: %w", err)
+	}
+
+	switch code := cmp.Or(result.StatusCode, result.StatusCodeSnake); code {
+	case 0:
+		return nil
+	case statusSessionExpired:
+		return fmt.Errorf("validate cookies: %w: %w", ErrCookiesExpired, ErrSessionExpired)
+	case statusLoginRequired:
+		return fmt.Errorf("validate cookies: %w", ErrAuthRequired)
+	default:
+		return fmt.Errorf("validate cookies: %w: status %d", ErrInvalidResponse, code)
+	}
+}
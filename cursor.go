@@ -0,0 +1,149 @@
+package tiktok
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Cursor is an opaque pagination position. Most TikTok endpoints page with a
+// single integer cursor; others (e.g. follower lists) use a
+// minCursor/maxCursor pair, and some send an opaque string. The zero Cursor
+// means "first page" when passed in and "no more pages" when returned.
+//
+// To checkpoint a crawl, save String() and restore it with ParseCursor.
+type Cursor struct {
+	simple   int
+	min, max int
+	compound bool
+
+	// token is a non-numeric string cursor, sent back verbatim.
+	token string
+}
+
+// EmptyCursor returns the cursor of the first page.
+func EmptyCursor() Cursor {
+	return Cursor{}
+}
+
+// simpleCursor returns an integer cursor.
+func simpleCursor(n int) Cursor {
+	return Cursor{simple: n}
+}
+
+// compoundCursor returns a minCursor/maxCursor cursor. compoundCursor(0, 0)
+// is the first page of a compound-paged endpoint.
+func compoundCursor(min, max int) Cursor {
+	return Cursor{min: min, max: max, compound: true}
+}
+
+// Int returns the position of an integer cursor, or 0 for compound and
+// string cursors.
+func (c Cursor) Int() int {
+	return c.simple
+}
+
+// IsCompound reports whether c is a minCursor/maxCursor pair.
+func (c Cursor) IsCompound() bool {
+	return c.compound
+}
+
+// MinMax returns the bounds of a compound cursor, or 0, 0 otherwise.
+func (c Cursor) MinMax() (min, max int) {
+	return c.min, c.max
+}
+
+// ParseCursor parses a cursor saved with Cursor.String. An empty s is the
+// first page.
+func ParseCursor(s string) (Cursor, error) {
+	var c Cursor
+	if s == "" {
+		return c, nil
+	}
+	if err := c.UnmarshalJSON([]byte(s)); err != nil {
+		return Cursor{}, fmt.Errorf("parse cursor: %w", err)
+	}
+	return c, nil
+}
+
+// String returns the cursor in its JSON form, e.g. "20",
+// `{"min":1,"max":2}` or `"token"`, which ParseCursor accepts.
+func (c Cursor) String() string {
+	b, _ := c.MarshalJSON()
+	return string(b)
+}
+
+// IsZero reports whether c is the zero Cursor.
+func (c Cursor) IsZero() bool {
+	return c == Cursor{}
+}
+
+// compoundCursorJSON is the JSON form of a compound Cursor.
+type compoundCursorJSON struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// MarshalJSON encodes simple cursors as an integer, compound cursors as
+// {"min": x, "max": y}, and string cursors as a JSON string.
+func (c Cursor) MarshalJSON() ([]byte, error) {
+	switch {
+	case c.compound:
+		return json.Marshal(compoundCursorJSON{Min: c.min, Max: c.max})
+	case c.token != "":
+		return json.Marshal(c.token)
+	}
+	return strconv.AppendInt(nil, int64(c.simple), 10), nil
+}
+
+// UnmarshalJSON accepts an integer, a string (numeric strings are treated as
+// integers, since some endpoints quote them), or a {"min": x, "max": y}
+// object.
+func (c *Cursor) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*c = Cursor{}
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		var cc compoundCursorJSON
+		if err := json.Unmarshal(data, &cc); err != nil {
+			return fmt.Errorf("decode compound cursor: %w", err)
+		}
+		*c = compoundCursor(cc.Min, cc.Max)
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("decode cursor %s: %w", data, err)
+		}
+		if n, err := strconv.Atoi(s); err == nil {
+			*c = simpleCursor(n)
+		} else {
+			*c = Cursor{token: s}
+		}
+		return nil
+	}
+
+	n, err := strconv.Atoi(string(data))
+	if err != nil {
+		return fmt.Errorf("decode cursor %s: %w", data, err)
+	}
+	*c = simpleCursor(n)
+	return nil
+}
+
+// setParams writes the cursor into API query params: "cursor" for simple and
+// string cursors, "minCursor"/"maxCursor" for compound ones.
+func (c Cursor) setParams(p map[string]string) {
+	switch {
+	case c.compound:
+		p["minCursor"] = strconv.Itoa(c.min)
+		p["maxCursor"] = strconv.Itoa(c.max)
+	case c.token != "":
+		p["cursor"] = c.token
+	default:
+		p["cursor"] = strconv.Itoa(c.simple)
+	}
+}
@@ -0,0 +1,95 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// defaultEffectPageSize is the page size TikTok's web client uses for effect feeds.
+const defaultEffectPageSize = 30
+
+// GetEffectByID fetches an effect's metadata and usage count. Returns
+// ErrNotFound when the effect does not exist. Requires an initialized browser.
+func (s *Scraper) GetEffectByID(ctx context.Context, effectID string) (Effect, error) {
+	if effectID == "" {
+		return Effect{}, fmt.Errorf("get effect: effect id is required")
+	}
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return Effect{}, fmt.Errorf("get effect %q: %w", effectID, err)
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/effect/detail/", func(p map[string]string) {
+		p["effectId"] = effectID
+	})
+	if err != nil {
+		return Effect{}, fmt.Errorf("get effect %q: %w", effectID, err)
+	}
+
+	var result effectDetailResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return Effect{}, fmt.Errorf("decode effect %q: %w", effectID, err)
+	}
+	if result.EffectInfo.Effect.ID == "" {
+		return Effect{}, fmt.Errorf("get effect %q: %w", effectID, ErrNotFound)
+	}
+	return parseEffect(result.EffectInfo), nil
+}
+
+// GetVideosByEffect returns up to limit videos made with the given effect.
+// Requires an initialized browser.
+func (s *Scraper) GetVideosByEffect(ctx context.Context, effectID string, limit int) ([]Video, error) {
+	if effectID == "" {
+		return nil, fmt.Errorf("get effect videos: effect id is required")
+	}
+
+	var allVideos []Video
+	var cursor Cursor
+
+	for len(allVideos) < limit {
+		if err := s.waitForSearch(ctx); err != nil {
+			return allVideos, fmt.Errorf("get effect videos %q: %w", effectID, err)
+		}
+
+		videos, nextCursor, hasMore, err := s.fetchEffectVideos(ctx, effectID, cursor)
+		if err != nil {
+			return allVideos, fmt.Errorf("get effect videos %q: %w", effectID, err)
+		}
+		allVideos = append(allVideos, videos...)
+		s.reportProgress(len(allVideos), limit)
+		if !hasMore {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(allVideos) > limit {
+		allVideos = allVideos[:limit]
+	}
+	return allVideos, nil
+}
+
+// fetchEffectVideos fetches one page of videos that use effectID.
+func (s *Scraper) fetchEffectVideos(ctx context.Context, effectID string, cursor Cursor) ([]Video, Cursor, bool, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/effect/item_list/", func(p map[string]string) {
+		p["effectId"] = effectID
+		p["count"] = strconv.Itoa(defaultEffectPageSize)
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, false, fmt.Errorf("effect videos: %w", err)
+	}
+
+	var result effectItemListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, false, fmt.Errorf("decode effect videos: %w", err)
+	}
+
+	videos := make([]Video, 0, len(result.ItemList))
+	for _, raw := range result.ItemList {
+		videos = append(videos, parseVideo(raw))
+	}
+	return videos, result.Cursor, bool(result.HasMore), nil
+}
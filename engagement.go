@@ -0,0 +1,58 @@
+package tiktok
+
+// Engagement-rate thresholds used by EngagementTier. The rate is
+// (likes + comments + shares) / views.
+const (
+	ViralEngagementRate   = 0.10
+	HighEngagementRate    = 0.05
+	AverageEngagementRate = 0.02
+)
+
+// Follower-count thresholds used by AuthorTier.
+const (
+	MegaFollowers  = 1_000_000
+	MacroFollowers = 100_000
+	MicroFollowers = 10_000
+)
+
+// EngagementTier classifies v by engagement rate: "viral" (>10%), "high"
+// (5-10%), "average" (2-5%), "low" (<2%), or "unknown" when v has no views.
+func EngagementTier(v Video) string {
+	if v.Views == 0 {
+		return "unknown"
+	}
+	rate := float64(v.Likes+v.Comments+v.Shares) / float64(v.Views)
+	switch {
+	case rate > ViralEngagementRate:
+		return "viral"
+	case rate >= HighEngagementRate:
+		return "high"
+	case rate >= AverageEngagementRate:
+		return "average"
+	default:
+		return "low"
+	}
+}
+
+// TierFilter keeps videos whose EngagementTier is tier.
+func TierFilter(tier string) VideoFilter {
+	return func(v Video) bool {
+		return EngagementTier(v) == tier
+	}
+}
+
+// AuthorTier classifies a creator by follower count: "mega" (>1M), "macro"
+// (100K-1M), "micro" (10K-100K), or "nano" (<10K). views is currently
+// unused; the tiers follow the industry convention of sizing by audience.
+func AuthorTier(views int64, followers int64) string {
+	switch {
+	case followers > MegaFollowers:
+		return "mega"
+	case followers >= MacroFollowers:
+		return "macro"
+	case followers >= MicroFollowers:
+		return "micro"
+	default:
+		return "nano"
+	}
+}
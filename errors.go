@@ -1,13 +1,75 @@
 package tiktok
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrRateLimited    = errors.New("tiktok: rate limited")
-	ErrNotFound       = errors.New("tiktok: not found")
-	ErrAuthRequired   = errors.New("tiktok: authentication required")
-	ErrCaptcha        = errors.New("tiktok: captcha required")
-	ErrSigningFailed  = errors.New("tiktok: url signing failed")
-	ErrBrowserNotReady = errors.New("tiktok: browser not initialized")
-	ErrInvalidResponse = errors.New("tiktok: invalid response")
+	ErrRateLimited        = errors.New("tiktok: rate limited")
+	ErrNotFound           = errors.New("tiktok: not found")
+	ErrAuthRequired       = errors.New("tiktok: authentication required")
+	ErrCaptcha            = errors.New("tiktok: captcha required")
+	ErrSigningFailed      = errors.New("tiktok: url signing failed")
+	ErrBrowserNotReady    = errors.New("tiktok: browser not initialized")
+	ErrInvalidResponse    = errors.New("tiktok: invalid response")
+	ErrPrivateAccount     = errors.New("tiktok: account is private")
+	ErrCommentsDisabled   = errors.New("tiktok: comments are disabled")
+	ErrBannedAccount      = errors.New("tiktok: account is banned")
+	ErrVideoUnavailable   = errors.New("tiktok: video unavailable")
+	ErrInvalidInput       = errors.New("tiktok: invalid input")
+	ErrMaxRetriesExceeded = errors.New("tiktok: max retries exceeded")
+	ErrCookiesExpired     = errors.New("tiktok: cookies expired")
+	ErrQRExpired          = errors.New("tiktok: qr code expired")
+	ErrRegionRestricted   = errors.New("tiktok: content restricted in region")
+	ErrNoRelatedHashtags  = errors.New("tiktok: no related hashtags")
+	ErrBrowserDead        = errors.New("tiktok: browser crashed and could not be restarted")
+	ErrSessionExpired     = errors.New("tiktok: session expired")
+)
+
+// TikTok API status codes carried in the JSON body of 200 responses.
+const (
+	statusSessionExpired   = 2061
+	statusRegionRestricted = 10000
+	statusVideoUnavailable = 10204
+	statusUserNotFound     = 10202
+	statusCommentsDisabled = 10208
+	statusBannedAccount    = 10221
+	statusLoginRequired    = 10222
+	statusPrivateAccount   = 10318
 )
+
+// authStatusError maps a TikTok auth status code to its sentinel, or nil if
+// code is not an auth failure. Expired cookies come back as 200 responses
+// with an empty list, so callers must check before parsing results.
+func authStatusError(code int) error {
+	switch code {
+	case statusSessionExpired:
+		return ErrSessionExpired
+	case statusLoginRequired:
+		return ErrAuthRequired
+	}
+	return nil
+}
+
+// apiStatusError is authStatusError for endpoints with no other documented
+// failure codes: any other non-zero code is an ErrInvalidResponse.
+func apiStatusError(code int) error {
+	if code == 0 {
+		return nil
+	}
+	if err := authStatusError(code); err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: status %d", ErrInvalidResponse, code)
+}
+
+// IsAuthError reports whether err means the session must be (re)authenticated,
+// i.e. it wraps ErrSessionExpired, ErrCookiesExpired or ErrAuthRequired.
+// ErrCookiesExpired is the cookie-side view of an expired session: LoadCookies
+// returns it when the saved cookies have lapsed, and ValidateCookies wraps it
+// together with ErrSessionExpired when TikTok reports status 2061.
+func IsAuthError(err error) bool {
+	return errors.Is(err, ErrSessionExpired) || errors.Is(err, ErrCookiesExpired) ||
+		errors.Is(err, ErrAuthRequired)
+}
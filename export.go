@@ -0,0 +1,122 @@
+package tiktok
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// videoCSVHeader is the column order written by WriteVideosCSV. Names match
+// the Video JSON keys.
+var videoCSVHeader = []string{
+	"id", "description", "author_id", "username", "author_verified", "created_at",
+	"views", "likes", "comments", "shares", "music_id", "music_title",
+}
+
+// userCSVHeader is the column order written by WriteUsersCSV. Names match
+// the Author JSON keys.
+var userCSVHeader = []string{
+	"id", "username", "sec_uid", "nickname", "follower_count", "following_count",
+	"video_count", "heart_count", "digg_count", "verified", "bio", "avatar_url",
+}
+
+// VideoList is a list of videos that encodes to JSON as an object,
+// {"videos": [...], "count": N, "exported_at": "<RFC 3339>"}, rather than a
+// bare array.
+type VideoList []Video
+
+// MarshalJSON implements json.Marshaler. exported_at is the time of
+// encoding, in UTC.
+func (l VideoList) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		l = VideoList{} // "videos": [], not null
+	}
+	return json.Marshal(struct {
+		Videos     []Video   `json:"videos"`
+		Count      int       `json:"count"`
+		ExportedAt time.Time `json:"exported_at"`
+	}{l, len(l), exportTime()})
+}
+
+// AuthorList is a list of authors that encodes to JSON as an object,
+// {"authors": [...], "count": N, "exported_at": "<RFC 3339>"}.
+type AuthorList []Author
+
+// MarshalJSON implements json.Marshaler. exported_at is the time of
+// encoding, in UTC.
+func (l AuthorList) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		l = AuthorList{}
+	}
+	return json.Marshal(struct {
+		Authors    []Author  `json:"authors"`
+		Count      int       `json:"count"`
+		ExportedAt time.Time `json:"exported_at"`
+	}{l, len(l), exportTime()})
+}
+
+// exportTime is the exported_at timestamp, truncated to seconds.
+func exportTime() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// ExportToJSON writes v to w as JSON followed by a newline. Pass a VideoList
+// or AuthorList to get a structured object instead of a bare array.
+func ExportToJSON(w io.Writer, v any) error {
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		return fmt.Errorf("export json: %w", err)
+	}
+	return nil
+}
+
+// WriteVideosCSV writes videos as CSV with a header row. CreatedAt is
+// formatted as RFC 3339 in UTC, or empty when unset.
+func WriteVideosCSV(w io.Writer, videos []Video) error {
+	rows := make([][]string, 0, len(videos))
+	for _, v := range videos {
+		rows = append(rows, []string{
+			v.ID, v.Description, v.AuthorID, v.Username,
+			strconv.FormatBool(v.AuthorVerified), formatCSVTime(v.CreatedAt),
+			strconv.FormatInt(v.Views, 10), strconv.FormatInt(v.Likes, 10),
+			strconv.FormatInt(v.Comments, 10), strconv.FormatInt(v.Shares, 10),
+			v.MusicID, v.MusicTitle,
+		})
+	}
+	return writeCSV(w, videoCSVHeader, rows)
+}
+
+// WriteUsersCSV writes authors as CSV with a header row.
+func WriteUsersCSV(w io.Writer, authors []Author) error {
+	rows := make([][]string, 0, len(authors))
+	for _, a := range authors {
+		rows = append(rows, []string{
+			a.ID, a.Username, a.SecUID, a.Nickname,
+			strconv.Itoa(a.FollowerCount), strconv.Itoa(a.FollowingCount),
+			strconv.Itoa(a.VideoCount), strconv.Itoa(a.HeartCount),
+			strconv.Itoa(a.DiggCount), strconv.FormatBool(a.Verified),
+			a.Bio, a.AvatarURL,
+		})
+	}
+	return writeCSV(w, userCSVHeader, rows)
+}
+
+func writeCSV(w io.Writer, header []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("write csv header: %w", err)
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("write csv rows: %w", err)
+	}
+	return nil
+}
+
+func formatCSVTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,133 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GetFeedVideos returns up to limit videos from the logged-in user's For You
+// feed. The feed is personalized and effectively endless, so limit should be
+// kept small. Requires authentication and an initialized browser.
+func (s *Scraper) GetFeedVideos(ctx context.Context, limit int) ([]Video, error) {
+	if !s.isLogged {
+		return nil, fmt.Errorf("get feed videos: %w", ErrAuthRequired)
+	}
+
+	var allVideos []Video
+	cursor := compoundCursor(0, 0)
+
+	for len(allVideos) < limit {
+		if err := s.waitForSearch(ctx); err != nil {
+			return allVideos, fmt.Errorf("get feed videos: %w", err)
+		}
+
+		videos, nextCursor, err := s.fetchFeedPage(ctx, cursor)
+		if err != nil {
+			return allVideos, fmt.Errorf("get feed videos: %w", err)
+		}
+		allVideos = append(allVideos, videos...)
+		s.reportProgress(len(allVideos), limit)
+		if nextCursor.IsZero() || len(videos) == 0 {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(allVideos) > limit {
+		allVideos = allVideos[:limit]
+	}
+	return allVideos, nil
+}
+
+// fetchFeedPage fetches one page of the For You feed. Unlike search, the feed
+// pages with a minCursor/maxCursor pair.
+func (s *Scraper) fetchFeedPage(ctx context.Context, cursor Cursor) ([]Video, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/feed/", func(p map[string]string) {
+		p["feed_type"] = "1"
+		p["count"] = "20"
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("feed: %w", err)
+	}
+
+	var result feedResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode feed: %w", err)
+	}
+	if err := apiStatusError(result.StatusCode); err != nil {
+		return nil, Cursor{}, fmt.Errorf("feed: %w", err)
+	}
+
+	videos := make([]Video, 0, len(result.ItemList))
+	for _, raw := range result.ItemList {
+		videos = append(videos, parseVideo(raw))
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = compoundCursor(result.MinCursor, result.MaxCursor)
+	}
+	return videos, nextCursor, nil
+}
+
+// GetTrendingVideos returns up to limit videos from TikTok's public
+// discovery feed. Unlike GetFeedVideos it needs no login. Requires an
+// initialized browser.
+func (s *Scraper) GetTrendingVideos(ctx context.Context, limit int) ([]Video, error) {
+	var allVideos []Video
+	var cursor Cursor
+
+	for len(allVideos) < limit {
+		if err := s.waitForSearch(ctx); err != nil {
+			return allVideos, fmt.Errorf("get trending videos: %w", err)
+		}
+
+		videos, nextCursor, err := s.fetchTrendingPage(ctx, cursor)
+		if err != nil {
+			return allVideos, fmt.Errorf("get trending videos: %w", err)
+		}
+		allVideos = append(allVideos, videos...)
+		s.reportProgress(len(allVideos), limit)
+		if nextCursor.IsZero() || len(videos) == 0 {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(allVideos) > limit {
+		allVideos = allVideos[:limit]
+	}
+	return allVideos, nil
+}
+
+func (s *Scraper) fetchTrendingPage(ctx context.Context, cursor Cursor) ([]Video, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/recommend/item_list/", func(p map[string]string) {
+		p["scene"] = "0"
+		p["count"] = "30"
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("trending: %w", err)
+	}
+
+	var result recommendItemListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode trending: %w", err)
+	}
+	if result.StatusCode == statusRegionRestricted {
+		return nil, Cursor{}, ErrRegionRestricted
+	}
+
+	videos := make([]Video, 0, len(result.ItemList))
+	for _, raw := range result.ItemList {
+		videos = append(videos, parseVideo(raw))
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = result.Cursor
+	}
+	return videos, nextCursor, nil
+}
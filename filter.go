@@ -0,0 +1,49 @@
+package tiktok
+
+import "slices"
+
+// VideoFilter reports whether a video should be kept.
+type VideoFilter func(Video) bool
+
+// FilterVideos returns the videos that pass every filter, preserving order.
+func FilterVideos(videos []Video, filters ...VideoFilter) []Video {
+	kept := make([]Video, 0, len(videos))
+	for _, v := range videos {
+		if matchesAll(v, filters) {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+func matchesAll(v Video, filters []VideoFilter) bool {
+	for _, f := range filters {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// StickerFilter keeps videos that use the sticker with the given ID, e.g. a
+// brand's custom sticker.
+func StickerFilter(stickerID string) VideoFilter {
+	return func(v Video) bool {
+		return slices.Contains(v.StickerIDs, stickerID)
+	}
+}
+
+// AIGeneratedFilter keeps videos TikTok labels as AI-generated.
+func AIGeneratedFilter() VideoFilter {
+	return func(v Video) bool {
+		return v.IsAIGenerated
+	}
+}
+
+// NotAIGeneratedFilter drops videos TikTok labels as AI-generated, e.g. to
+// keep them out of sponsored placements. Unlabeled AI content still passes.
+func NotAIGeneratedFilter() VideoFilter {
+	return func(v Video) bool {
+		return !v.IsAIGenerated
+	}
+}
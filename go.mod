@@ -1,11 +1,12 @@
 module github.com/RavensCloud/tiktok-gofun
 
-go 1.25
+go 1.25.0
 
 require (
 	github.com/go-rod/rod v0.116.2
 	github.com/go-rod/stealth v0.4.9
 	golang.org/x/net v0.34.0
+	golang.org/x/time v0.15.0
 )
 
 require (
@@ -0,0 +1,129 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// GetHashtagInfo returns a hashtag's metadata and stats. Returns ErrNotFound
+// when the hashtag does not exist. Requires an initialized browser.
+func (s *Scraper) GetHashtagInfo(ctx context.Context, hashtag string) (Hashtag, error) {
+	if hashtag == "" {
+		return Hashtag{}, fmt.Errorf("get hashtag info: hashtag is required")
+	}
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return Hashtag{}, fmt.Errorf("get hashtag info %q: %w", hashtag, err)
+	}
+
+	info, err := s.fetchChallengeInfo(ctx, hashtag)
+	if err != nil {
+		return Hashtag{}, fmt.Errorf("get hashtag info %q: %w", hashtag, err)
+	}
+	return parseChallengeInfo(info), nil
+}
+
+// GetSuggestedHashtags returns up to limit hashtags matching the prefix, as
+// suggested by TikTok's search bar. Pure HTTP — no browser signing required.
+func (s *Scraper) GetSuggestedHashtags(ctx context.Context, prefix string, limit int) ([]Hashtag, error) {
+	if prefix == "" {
+		return nil, fmt.Errorf("get suggested hashtags: prefix is required")
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	params := s.buildAPIParams()
+	params.Set("keyword", prefix)
+	params.Set("count", strconv.Itoa(limit))
+	reqURL := s.baseURL + "/api/challenge/search/?" + params.Encode()
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return nil, fmt.Errorf("get suggested hashtags %q: %w", prefix, err)
+	}
+
+	resp, err := s.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get suggested hashtags %q: %w", prefix, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read suggested hashtags %q: %w", prefix, err)
+	}
+
+	var result challengeSearchResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode suggested hashtags %q: %w", prefix, err)
+	}
+
+	hashtags := make([]Hashtag, 0, len(result.ChallengeList))
+	for _, item := range result.ChallengeList {
+		hashtags = append(hashtags, parseSearchedChallenge(item.ChallengeInfo))
+	}
+	if len(hashtags) > limit {
+		hashtags = hashtags[:limit]
+	}
+	return hashtags, nil
+}
+
+// GetHashtagRelated returns up to limit hashtags TikTok considers related to
+// the given one. Returns ErrNotFound when the hashtag does not exist and
+// ErrNoRelatedHashtags when TikTok suggests none. Requires an initialized
+// browser.
+func (s *Scraper) GetHashtagRelated(ctx context.Context, hashtag string, limit int) ([]Hashtag, error) {
+	if hashtag == "" {
+		return nil, fmt.Errorf("get related hashtags: hashtag is required")
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return nil, fmt.Errorf("get related hashtags %q: %w", hashtag, err)
+	}
+
+	challengeID, err := s.getChallengeID(ctx, hashtag)
+	if err != nil {
+		return nil, fmt.Errorf("get related hashtags %q: %w", hashtag, err)
+	}
+
+	hashtags, err := s.fetchRelatedHashtags(ctx, challengeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("get related hashtags %q: %w", hashtag, err)
+	}
+	return hashtags, nil
+}
+
+// fetchRelatedHashtags fetches up to limit hashtags related to challengeID.
+func (s *Scraper) fetchRelatedHashtags(ctx context.Context, challengeID string, limit int) ([]Hashtag, error) {
+	if err := s.waitForSearch(ctx); err != nil {
+		return nil, err
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/recommend/item_list/challenge/", func(p map[string]string) {
+		p["challengeID"] = challengeID
+		p["count"] = strconv.Itoa(limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var result challengeSearchResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode related hashtags: %w", err)
+	}
+	if len(result.ChallengeList) == 0 {
+		return nil, ErrNoRelatedHashtags
+	}
+
+	hashtags := make([]Hashtag, 0, min(len(result.ChallengeList), limit))
+	for _, item := range result.ChallengeList[:min(len(result.ChallengeList), limit)] {
+		hashtags = append(hashtags, parseSearchedChallenge(item.ChallengeInfo))
+	}
+	return hashtags, nil
+}
@@ -0,0 +1,112 @@
+package tiktok
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// healthCheckTimeout bounds each individual check in HealthCheck.
+const healthCheckTimeout = 2 * time.Second
+
+// HealthReport is a point-in-time diagnostic of the Scraper's subsystems.
+// A failing check only clears its own flag; details are collected in Errors.
+type HealthReport struct {
+	NetworkOK        bool          // tiktok.com reachable via the HTTP client (and proxy).
+	BrowserOK        bool          // Headless browser is running and responsive.
+	SigningOK        bool          // frontierSign is available in the page.
+	AuthOK           bool          // Logged in with an unexpired session cookie.
+	APILatency       time.Duration // Round-trip time of the network check.
+	BrowserVersion   string
+	SessionExpiresAt time.Time // Zero if unknown.
+	Errors           []error
+}
+
+// OK reports whether every check passed.
+func (r HealthReport) OK() bool {
+	return r.NetworkOK && r.BrowserOK && r.SigningOK && r.AuthOK
+}
+
+// HealthCheck runs the network, browser, signing, and auth checks in parallel,
+// each with its own 2s timeout, and returns a combined report. Suitable for
+// readiness probes.
+func (s *Scraper) HealthCheck(ctx context.Context) HealthReport {
+	report := HealthReport{SessionExpiresAt: s.authCookieExpiry("sessionid")}
+
+	errs := runChecks(ctx, healthCheckTimeout,
+		func(ctx context.Context) (err error) {
+			report.APILatency, err = s.CheckConnection(ctx)
+			return err
+		},
+		func(ctx context.Context) (err error) {
+			report.BrowserVersion, err = s.checkBrowser(ctx)
+			return err
+		},
+		s.checkSigning,
+		func(context.Context) error { return s.checkAuth() },
+	)
+
+	report.NetworkOK = errs[0] == nil
+	report.BrowserOK = errs[1] == nil
+	report.SigningOK = errs[2] == nil
+	report.AuthOK = errs[3] == nil
+	for _, err := range errs {
+		if err != nil {
+			report.Errors = append(report.Errors, err)
+		}
+	}
+	return report
+}
+
+// Ping is a cheap liveness probe for long-running services: a HEAD request
+// to TikTok through the HTTP client (and proxy) and, if the browser is
+// running, a trivial eval in its page. Each check has a 2s timeout; failures
+// are combined with errors.Join. Unlike HealthCheck it ignores signing and
+// auth state.
+func (s *Scraper) Ping(ctx context.Context) error {
+	return errors.Join(runChecks(ctx, healthCheckTimeout, s.pingHTTP, s.pingBrowser)...)
+}
+
+func (s *Scraper) pingHTTP(ctx context.Context) error {
+	resp, err := s.doRequest(ctx, http.MethodHead, s.baseURL, nil)
+	if err != nil {
+		return fmt.Errorf("ping http: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("ping http: status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// runChecks runs each check concurrently with its own timeout and returns
+// their errors in the same order as the checks.
+func runChecks(ctx context.Context, timeout time.Duration, checks ...func(context.Context) error) []error {
+	errs := make([]error, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			errs[i] = check(checkCtx)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+// checkAuth verifies the Scraper is logged in and the session has not expired.
+func (s *Scraper) checkAuth() error {
+	if !s.isLogged {
+		return fmt.Errorf("auth check: %w", ErrAuthRequired)
+	}
+	if exp := s.authCookieExpiry("sessionid"); !exp.IsZero() && time.Now().After(exp) {
+		return fmt.Errorf("auth check: %w: session expired at %s", ErrAuthRequired, exp.Format(time.RFC3339))
+	}
+	return nil
+}
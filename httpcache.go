@@ -0,0 +1,109 @@
+package tiktok
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// volatileParams change on every request and are left out of cache keys so
+// recorded responses can be replayed.
+var volatileParams = []string{"history_len", "msToken", "X-Bogus", "X-Gnarly"}
+
+// WithHTTPCacheDir records successful GET responses made through the HTTP
+// client to <dir>/<sha256 of URL>.json and replays them on later requests
+// for the same URL without touching the network. Per-request params such as
+// msToken and history_len are ignored when keying. Browser fetches bypass
+// the HTTP client and are never cached. Intended for debugging and offline
+// tests; an empty dir disables the cache.
+func (s *Scraper) WithHTTPCacheDir(dir string) *Scraper {
+	s.httpCacheDir = dir
+	s.rebuildTransport()
+	return s
+}
+
+// cacheTransport is an http.RoundTripper that records and replays response
+// bodies on disk.
+type cacheTransport struct {
+	dir    string
+	next   http.RoundTripper
+	logger func() *slog.Logger
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.next.RoundTrip(req)
+	}
+
+	path := filepath.Join(t.dir, cacheKey(req.URL)+".json")
+	if body, err := os.ReadFile(path); err == nil {
+		t.logger().Debug("http cache hit", slog.String("path", req.URL.Path))
+		return cachedResponse(req, body), nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read response for cache: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	// A failed write only costs a future cache miss.
+	if err := writeCacheFile(path, body); err != nil {
+		t.logger().Debug("http cache write failed", slog.Any("error", err))
+	}
+	return resp, nil
+}
+
+// cacheKey hashes the URL with volatile params removed.
+func cacheKey(u *url.URL) string {
+	stable := *u
+	stable.RawQuery = stableQuery(u.Query())
+	sum := sha256.Sum256([]byte(stable.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// stableQuery encodes q without volatileParams. q is not modified.
+func stableQuery(q url.Values) string {
+	q = maps.Clone(q)
+	for _, p := range volatileParams {
+		q.Del(p)
+	}
+	return q.Encode()
+}
+
+func cachedResponse(req *http.Request, body []byte) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func writeCacheFile(path string, body []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create cache dir: %w", err)
+	}
+	if err := os.WriteFile(path, body, 0600); err != nil {
+		return fmt.Errorf("write cache file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GetLiveStreams returns up to limit live streams TikTok currently
+// recommends. Requires an initialized browser.
+func (s *Scraper) GetLiveStreams(ctx context.Context, limit int) ([]LiveStream, error) {
+	var all []LiveStream
+	var cursor Cursor
+
+	for len(all) < limit {
+		if err := s.waitForSearch(ctx); err != nil {
+			return all, fmt.Errorf("get live streams: %w", err)
+		}
+
+		streams, nextCursor, err := s.fetchLiveStreams(ctx, cursor)
+		if err != nil {
+			return all, fmt.Errorf("get live streams: %w", err)
+		}
+		all = append(all, streams...)
+		if nextCursor.IsZero() {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(all) > limit {
+		all = all[:limit]
+	}
+	return all, nil
+}
+
+func (s *Scraper) fetchLiveStreams(ctx context.Context, cursor Cursor) ([]LiveStream, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/recommend/search/live/", func(p map[string]string) {
+		p["count"] = "20"
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("live streams: %w", err)
+	}
+
+	var result liveListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode live streams: %w", err)
+	}
+	if result.StatusCode == statusRegionRestricted {
+		return nil, Cursor{}, ErrRegionRestricted
+	}
+
+	streams := make([]LiveStream, 0, len(result.RoomList))
+	for _, raw := range result.RoomList {
+		streams = append(streams, parseLiveRoom(raw))
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = result.Cursor
+	}
+	return streams, nextCursor, nil
+}
@@ -0,0 +1,103 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// defaultSoundPageSize is the page size TikTok's web client uses for sound feeds.
+const defaultSoundPageSize = 30
+
+// GetSoundByID fetches a sound's metadata and usage count. Returns
+// ErrNotFound when the sound does not exist. Requires an initialized browser.
+func (s *Scraper) GetSoundByID(ctx context.Context, musicID string) (Sound, error) {
+	if musicID == "" {
+		return Sound{}, fmt.Errorf("get sound: music id is required")
+	}
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return Sound{}, fmt.Errorf("get sound %q: %w", musicID, err)
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/music/detail/", func(p map[string]string) {
+		p["musicId"] = musicID // The web client spells it musicID on item_list.
+	})
+	if err != nil {
+		return Sound{}, fmt.Errorf("get sound %q: %w", musicID, err)
+	}
+
+	var result musicDetailResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return Sound{}, fmt.Errorf("decode sound %q: %w", musicID, err)
+	}
+	if result.MusicInfo.Music.ID == "" {
+		return Sound{}, fmt.Errorf("get sound %q: %w", musicID, ErrNotFound)
+	}
+	return parseSound(result.MusicInfo), nil
+}
+
+// GetSoundVideos returns up to limit videos that use the given sound.
+// Requires an initialized browser and authentication.
+func (s *Scraper) GetSoundVideos(ctx context.Context, musicID string, limit int) ([]Video, error) {
+	if musicID == "" {
+		return nil, fmt.Errorf("get sound videos: music id is required")
+	}
+
+	var allVideos []Video
+	var cursor Cursor
+
+	for len(allVideos) < limit {
+		videos, nextCursor, hasMore, err := s.GetVideosBySoundPage(ctx, musicID, cursor, defaultSoundPageSize)
+		if err != nil {
+			return allVideos, fmt.Errorf("get sound videos %q: %w", musicID, err)
+		}
+		allVideos = append(allVideos, videos...)
+		if !hasMore {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(allVideos) > limit {
+		allVideos = allVideos[:limit]
+	}
+	return allVideos, nil
+}
+
+// GetVideosBySoundPage fetches a single page of videos that use the given
+// sound, starting at cursor. It returns the videos, the cursor for the next
+// page, and whether more pages are available. A pageSize <= 0 uses the default.
+func (s *Scraper) GetVideosBySoundPage(ctx context.Context, musicID string, cursor Cursor, pageSize int) ([]Video, Cursor, bool, error) {
+	if musicID == "" {
+		return nil, Cursor{}, false, fmt.Errorf("get videos by sound: music id is required")
+	}
+	if pageSize <= 0 {
+		pageSize = defaultSoundPageSize
+	}
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return nil, Cursor{}, false, fmt.Errorf("sound videos: %w", err)
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/music/item_list/", func(p map[string]string) {
+		p["musicID"] = musicID // Capital "ID" here, unlike /api/music/detail/.
+		p["count"] = strconv.Itoa(pageSize)
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, false, fmt.Errorf("sound videos: %w", err)
+	}
+
+	var result musicItemListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, false, fmt.Errorf("decode sound videos: %w", err)
+	}
+
+	videos := make([]Video, 0, len(result.ItemList))
+	for _, raw := range result.ItemList {
+		videos = append(videos, parseVideo(raw))
+	}
+	return videos, result.Cursor, bool(result.HasMore), nil
+}
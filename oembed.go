@@ -0,0 +1,101 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// OEmbedResponse is the embed metadata TikTok's oEmbed endpoint returns for
+// a video.
+type OEmbedResponse struct {
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name"`
+	AuthorURL    string `json:"author_url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	HTML         string `json:"html"`   // Embed snippet (<blockquote> + script).
+	Width        string `json:"width"`  // e.g. "100%"
+	Height       string `json:"height"` // e.g. "100%"
+}
+
+// GetOEmbedData fetches embed metadata for a video share URL via TikTok's
+// public oEmbed endpoint. It needs neither a browser nor a session, which
+// makes it the cheapest way to get a video's title, author and thumbnail.
+// Returns ErrNotFound when TikTok does not know the URL.
+func (s *Scraper) GetOEmbedData(ctx context.Context, videoURL string) (OEmbedResponse, error) {
+	if videoURL == "" {
+		return OEmbedResponse{}, fmt.Errorf("get oembed: %w: video url is required", ErrInvalidInput)
+	}
+	reqURL := s.baseURL + "/oembed?url=" + url.QueryEscape(videoURL)
+
+	if err := s.waitForProfile(ctx); err != nil {
+		return OEmbedResponse{}, fmt.Errorf("get oembed %q: %w", videoURL, err)
+	}
+
+	resp, err := s.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return OEmbedResponse{}, fmt.Errorf("get oembed %q: %w", videoURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return OEmbedResponse{}, fmt.Errorf("get oembed %q: %w: http %d", videoURL, ErrInvalidResponse, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return OEmbedResponse{}, fmt.Errorf("read oembed %q: %w", videoURL, err)
+	}
+
+	var result OEmbedResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return OEmbedResponse{}, fmt.Errorf("decode oembed %q: %w", videoURL, err)
+	}
+	return result, nil
+}
+
+// ParseVideoURL extracts the video ID and author username from a TikTok
+// video URL such as https://www.tiktok.com/@user/video/123 (photo posts under
+// /photo/ work too). Mobile links of the form https://m.tiktok.com/v/123.html
+// carry no username, so username is empty for them. Short links
+// (vm.tiktok.com) must be resolved by following their redirect first.
+// Returns ErrInvalidInput for anything else.
+func ParseVideoURL(rawURL string) (videoID, username string, err error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || !isTikTokHost(u.Hostname()) {
+		return "", "", fmt.Errorf("parse video url %q: %w: not a tiktok url", rawURL, ErrInvalidInput)
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	switch {
+	case len(parts) >= 3 && strings.HasPrefix(parts[0], "@") && (parts[1] == "video" || parts[1] == "photo"):
+		videoID, username = parts[2], strings.TrimPrefix(parts[0], "@")
+	case len(parts) == 2 && parts[0] == "v":
+		videoID = strings.TrimSuffix(parts[1], ".html")
+	}
+	if !isDigits(videoID) {
+		return "", "", fmt.Errorf("parse video url %q: %w: no video id", rawURL, ErrInvalidInput)
+	}
+	return videoID, username, nil
+}
+
+// isTikTokHost reports whether host is tiktok.com or a subdomain of it.
+func isTikTokHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "tiktok.com" || strings.HasSuffix(host, ".tiktok.com")
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,168 @@
+package tiktok
+
+import (
+	"context"
+	"fmt"
+)
+
+// SearchPager fetches video search results one page at a time, for callers
+// that want explicit control over pagination rather than a limit. Create one
+// with NewSearchPager. A pager is not safe for concurrent use.
+type SearchPager struct {
+	s       *Scraper
+	keyword string
+	cursor  Cursor
+	done    bool
+}
+
+// NewSearchPager returns a pager over the video search results for keyword.
+// Fetching requires an initialized browser (InitBrowser) and authentication.
+func (s *Scraper) NewSearchPager(keyword string) *SearchPager {
+	return &SearchPager{s: s, keyword: keyword}
+}
+
+// FetchPage fetches the next page of results. Once HasMore reports false it
+// returns no videos and a nil error. On error the position is unchanged, so
+// the page can be retried.
+func (p *SearchPager) FetchPage(ctx context.Context) ([]Video, error) {
+	if p.keyword == "" {
+		return nil, fmt.Errorf("search page: keyword is required")
+	}
+	if p.done {
+		return nil, nil
+	}
+
+	if err := p.s.waitForSearch(ctx); err != nil {
+		return nil, fmt.Errorf("search page %q: %w", p.keyword, err)
+	}
+
+	videos, next, err := p.s.fetchSearch(ctx, p.keyword, p.cursor)
+	if err != nil {
+		return nil, fmt.Errorf("search page %q: %w", p.keyword, err)
+	}
+	p.cursor, p.done = next, next.IsZero()
+	return videos, nil
+}
+
+// HasMore reports whether another page may be available.
+func (p *SearchPager) HasMore() bool { return !p.done }
+
+// Cursor returns the position of the next page. Save its String() to resume
+// later with SetCursor.
+func (p *SearchPager) Cursor() Cursor { return p.cursor }
+
+// SetCursor moves the pager to c, e.g. a checkpoint restored with
+// ParseCursor, so the next FetchPage continues from there.
+func (p *SearchPager) SetCursor(c Cursor) { p.cursor, p.done = c, false }
+
+// Reset rewinds the pager to the first page.
+func (p *SearchPager) Reset() { p.cursor, p.done = Cursor{}, false }
+
+// streamBufferSize is the channel buffer of SearchVideosStream.
+const streamBufferSize = 32
+
+// VideoResult is one item from SearchVideosStream: a video, or the error
+// that ended the stream.
+type VideoResult struct {
+	Video Video
+	Err   error
+}
+
+// SearchVideosStream pages through all search results for keyword in a
+// goroutine, sending each video on the returned channel as its page arrives.
+// A failure is sent as a final result with Err set. The channel is closed
+// when results run out, after an error, or once ctx is done; cancel ctx to
+// stop early. Requires an initialized browser (InitBrowser) and
+// authentication.
+func (s *Scraper) SearchVideosStream(ctx context.Context, keyword string) <-chan VideoResult {
+	out := make(chan VideoResult, streamBufferSize)
+	go func() {
+		defer close(out)
+		send := func(r VideoResult) bool {
+			select {
+			case out <- r:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		p := s.NewSearchPager(keyword)
+		for p.HasMore() && ctx.Err() == nil {
+			videos, err := p.FetchPage(ctx)
+			if err != nil {
+				send(VideoResult{Err: err})
+				return
+			}
+			for _, v := range videos {
+				if !send(VideoResult{Video: v}) {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// HashtagPager fetches a hashtag's videos one page at a time. Create one with
+// NewHashtagPager. A pager is not safe for concurrent use.
+type HashtagPager struct {
+	s           *Scraper
+	hashtag     string
+	challengeID string // Resolved on the first fetch.
+	cursor      Cursor
+	done        bool
+}
+
+// NewHashtagPager returns a pager over the videos tagged with hashtag.
+// Fetching requires an initialized browser (InitBrowser).
+func (s *Scraper) NewHashtagPager(hashtag string) *HashtagPager {
+	return &HashtagPager{s: s, hashtag: hashtag}
+}
+
+// FetchPage fetches the next page of videos, looking up the hashtag's
+// challenge ID first if needed. Once HasMore reports false it returns no
+// videos and a nil error. On error the position is unchanged.
+func (p *HashtagPager) FetchPage(ctx context.Context) ([]Video, error) {
+	if p.hashtag == "" {
+		return nil, fmt.Errorf("hashtag page: hashtag is required")
+	}
+	if p.done {
+		return nil, nil
+	}
+
+	if p.challengeID == "" {
+		if err := p.s.waitForSearch(ctx); err != nil {
+			return nil, fmt.Errorf("hashtag page %q: %w", p.hashtag, err)
+		}
+		id, err := p.s.getChallengeID(ctx, p.hashtag)
+		if err != nil {
+			return nil, fmt.Errorf("hashtag page %q: %w", p.hashtag, err)
+		}
+		p.challengeID = id
+	}
+
+	if err := p.s.waitForSearch(ctx); err != nil {
+		return nil, fmt.Errorf("hashtag page %q: %w", p.hashtag, err)
+	}
+
+	videos, next, err := p.s.fetchHashtagVideos(ctx, p.challengeID, p.cursor)
+	if err != nil {
+		return nil, fmt.Errorf("hashtag page %q: %w", p.hashtag, err)
+	}
+	p.cursor, p.done = next, next.IsZero()
+	return videos, nil
+}
+
+// HasMore reports whether another page may be available.
+func (p *HashtagPager) HasMore() bool { return !p.done }
+
+// Cursor returns the position of the next page. Save its String() to resume
+// later with SetCursor.
+func (p *HashtagPager) Cursor() Cursor { return p.cursor }
+
+// SetCursor moves the pager to c, so the next FetchPage continues from there.
+func (p *HashtagPager) SetCursor(c Cursor) { p.cursor, p.done = c, false }
+
+// Reset rewinds the pager to the first page. The challenge ID is kept.
+func (p *HashtagPager) Reset() { p.cursor, p.done = Cursor{}, false }
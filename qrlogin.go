@@ -0,0 +1,111 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// qrPollInterval is how often QRSession.Wait polls the check endpoint.
+const qrPollInterval = 2 * time.Second
+
+// QR login statuses reported by /api/qrcode/check/. Any other status (new,
+// scanned) means the code is still pending.
+const (
+	qrStatusConfirmed = "confirmed"
+	qrStatusExpired   = "qrcode_expired"
+)
+
+// QRSession is a pending QR-code login started by StartQRLogin. Show
+// ImageURL to the user, then call Wait.
+type QRSession struct {
+	ImageURL string // URL to render as a QR code and scan with the TikTok app.
+	Token    string
+
+	s        *Scraper
+	interval time.Duration
+}
+
+// StartQRLogin starts a QR-code login, for accounts where Login cannot get
+// past 2FA. It uses the HTTP client only; no browser is required.
+func (s *Scraper) StartQRLogin(ctx context.Context) (*QRSession, error) {
+	body, _, err := s.qrRequest(ctx, "/api/qrcode/generate/", nil)
+	if err != nil {
+		return nil, fmt.Errorf("start qr login: %w", err)
+	}
+
+	var result rawQRGenerateResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode qr code: %w", err)
+	}
+	if result.StatusCode != 0 || result.Data.Token == "" {
+		return nil, fmt.Errorf("start qr login: status %d: %w", result.StatusCode, ErrInvalidResponse)
+	}
+
+	return &QRSession{
+		ImageURL: result.Data.QRCodeURL,
+		Token:    result.Data.Token,
+		s:        s,
+		interval: qrPollInterval,
+	}, nil
+}
+
+// Wait polls the login status every two seconds until the user confirms the
+// login in the app, the code expires (ErrQRExpired), the check endpoint
+// reports an error status (ErrInvalidResponse), or ctx is done. On
+// confirmation the session cookies are stored and the Scraper is logged in.
+func (q *QRSession) Wait(ctx context.Context) error {
+	params := url.Values{"token": {q.Token}}
+	for {
+		body, cookies, err := q.s.qrRequest(ctx, "/api/qrcode/check/", params)
+		if err != nil {
+			return fmt.Errorf("check qr login: %w", err)
+		}
+
+		var result rawQRCheckResponse
+		if err := json.Unmarshal(body, &result); err != nil {
+			return fmt.Errorf("decode qr status: %w", err)
+		}
+		if result.StatusCode != 0 {
+			return fmt.Errorf("check qr login: status %d: %w", result.StatusCode, ErrInvalidResponse)
+		}
+
+		switch result.Data.Status {
+		case qrStatusConfirmed:
+			q.s.SetCookies(cookies)
+			q.s.isLogged = true
+			return nil
+		case qrStatusExpired:
+			return ErrQRExpired
+		}
+
+		if err := sleepContext(ctx, q.interval); err != nil {
+			return fmt.Errorf("wait for qr login: %w", err)
+		}
+	}
+}
+
+// qrRequest GETs a QR login endpoint and returns the body along with any
+// cookies the response set.
+func (s *Scraper) qrRequest(ctx context.Context, path string, extra url.Values) ([]byte, []*http.Cookie, error) {
+	params := s.buildAPIParams()
+	for k, v := range extra {
+		params[k] = v
+	}
+
+	resp, err := s.doRequest(ctx, "GET", s.baseURL+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read body: %w", err)
+	}
+	return body, resp.Cookies(), nil
+}
@@ -0,0 +1,28 @@
+package tiktok
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter paces API requests. Wait blocks until the next request may be
+// sent, or returns an error if ctx is done first. A single RateLimiter may
+// be shared by several Scrapers to cap their combined request rate.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// NewTokenBucketRateLimiter returns a RateLimiter allowing rps requests per
+// second with no bursting, backed by golang.org/x/time/rate.
+func NewTokenBucketRateLimiter(rps float64) RateLimiter {
+	return rate.NewLimiter(rate.Limit(rps), 1)
+}
+
+// WithRateLimiter paces search and profile requests with rl instead of the
+// built-in delays (WithSearchDelay, WithProfileDelay), which are then
+// ignored. A nil rl restores the built-in delays.
+func (s *Scraper) WithRateLimiter(rl RateLimiter) *Scraper {
+	s.rateLimiter = rl
+	return s
+}
@@ -0,0 +1,119 @@
+package tiktok
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// WithRetry makes HTTP requests retry on 429 and 5xx responses up to
+// maxRetries times, sleeping baseDelay * 2^attempt ±25% between attempts. A
+// Retry-After response header overrides the computed delay. When retries run
+// out the error wraps both ErrMaxRetriesExceeded and the last failure.
+//
+// Only requests made via the HTTP client are retried; browser fetches report
+// no status code to act on. maxRetries <= 0 disables retrying (the default).
+func (s *Scraper) WithRetry(maxRetries int, baseDelay time.Duration) *Scraper {
+	s.maxRetries = max(maxRetries, 0)
+	s.retryBaseDelay = baseDelay
+	return s
+}
+
+// RateLimitInfo describes the most recent HTTP 429 response.
+type RateLimitInfo struct {
+	At         time.Time     // When the 429 was received; zero if none yet.
+	RetryAfter time.Duration // From the Retry-After header; zero if absent.
+}
+
+// LastRateLimitInfo returns details of the most recent 429 seen by the HTTP
+// client, e.g. to pause a crawl for RetryAfter after ErrRateLimited. With
+// WithRetry the same Retry-After value already replaces the backoff delay.
+func (s *Scraper) LastRateLimitInfo() RateLimitInfo {
+	s.rateLimitMu.Lock()
+	defer s.rateLimitMu.Unlock()
+	return s.lastRateLimit
+}
+
+// RateLimitStats counts time spent rate limiting since the Scraper was
+// created or ResetRateLimitStats was called.
+type RateLimitStats struct {
+	TotalWaits        int64         // Built-in throttle delays that actually slept.
+	TotalWaitDuration time.Duration // Time slept in those delays.
+	RateLimitHits     int64         // HTTP 429 responses seen by the HTTP client.
+}
+
+// GetRateLimitStats returns the rate limiting counters. Waits inside a
+// custom RateLimiter (WithRateLimiter) are not counted.
+func (s *Scraper) GetRateLimitStats() RateLimitStats {
+	return RateLimitStats{
+		TotalWaits:        s.throttleWaits.Load(),
+		TotalWaitDuration: time.Duration(s.throttleWaitNanos.Load()),
+		RateLimitHits:     s.rateLimitHits.Load(),
+	}
+}
+
+// ResetRateLimitStats zeroes the counters behind GetRateLimitStats.
+func (s *Scraper) ResetRateLimitStats() {
+	s.throttleWaits.Store(0)
+	s.throttleWaitNanos.Store(0)
+	s.rateLimitHits.Store(0)
+}
+
+func (s *Scraper) recordRateLimit(h http.Header) {
+	s.rateLimitHits.Add(1)
+	retryAfter, _ := parseRetryAfter(h.Get("Retry-After"))
+	s.rateLimitMu.Lock()
+	defer s.rateLimitMu.Unlock()
+	s.lastRateLimit = RateLimitInfo{At: time.Now(), RetryAfter: retryAfter}
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+func retryableStatusError(code int) error {
+	if code == http.StatusTooManyRequests {
+		return ErrRateLimited
+	}
+	return fmt.Errorf("server error: HTTP %d", code)
+}
+
+// retryDelay returns how long to wait before retry attempt+1.
+func (s *Scraper) retryDelay(attempt int, h http.Header) time.Duration {
+	if d, ok := parseRetryAfter(h.Get("Retry-After")); ok {
+		return d
+	}
+	d := s.retryBaseDelay << attempt
+	jitter := (rand.Float64()*0.5 - 0.25) * float64(d)
+	return d + time.Duration(jitter)
+}
+
+// parseRetryAfter parses a Retry-After value in delay-seconds or HTTP-date
+// form. Dates in the past yield a zero delay.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// sleepContext sleeps for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -1,17 +1,24 @@
 package tiktok
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"maps"
 	"math/rand/v2"
 	"net"
 	"net/http"
 	"net/http/cookiejar"
 	"net/url"
 	"os"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -22,15 +29,16 @@ import (
 
 const defaultUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36"
 
-// debugPerf enables performance timing output to stderr.
-var debugPerf bool
+// Default locale reported in API params (see WithRegion, WithLanguage,
+// WithTimezone).
+const (
+	defaultRegion   = "US"
+	defaultLanguage = "en"
+	defaultTimezone = "America/New_York"
+)
 
-// perfLog prints a performance timing message to stderr when debug is enabled.
-func perfLog(format string, args ...any) {
-	if debugPerf {
-		fmt.Fprintf(os.Stderr, "[perf] "+format+"\n", args...)
-	}
-}
+// chromeVersionRe extracts the Chrome major version from a User-Agent.
+var chromeVersionRe = regexp.MustCompile(`Chrome/(\d+)`)
 
 var tiktokURL, _ = url.Parse("https://www.tiktok.com")
 
@@ -40,6 +48,9 @@ type Scraper struct {
 	client    *http.Client
 	proxy     string
 	userAgent string
+	region    string // region param, defaults to "US"
+	language  string // language params, defaults to "en"
+	timezone  string // tz_name param, defaults to "America/New_York"
 	isLogged  bool
 	baseURL   string // defaults to "https://www.tiktok.com"
 
@@ -52,26 +63,146 @@ type Scraper struct {
 	// signFunc signs a raw URL via browser JS. Replaceable for testing.
 	signFunc func(rawURL string) (string, error)
 
-	// fetchFunc signs a URL and fetches it inside the browser via JS fetch().
-	// Uses the browser's TLS fingerprint and cookies. Replaceable for testing.
-	fetchFunc func(rawURL string) ([]byte, error)
+	// fetchFunc signs a URL and fetches it inside the browser page via JS
+	// fetch(). Uses the browser's TLS fingerprint and cookies. Replaceable for
+	// testing.
+	fetchFunc func(page *rod.Page, rawURL string) ([]byte, error)
+
+	// Optional page pool for concurrent fetches (see WithBrowserPoolSize).
+	// When nil, fetches share s.page under browserMu.
+	browserPoolSize int
+	pool            *browserPool
+
+	// Chrome binary and extra command-line flags for launchBrowser (see
+	// WithBrowserExecutable, WithBrowserFlags).
+	browserExecPath string
+	browserFlags    []string
+
+	// headful shows the browser window (see WithHeadful).
+	headful bool
+
+	// Crash recovery (see WithMaxBrowserRestarts). Fetches hold
+	// browserStateMu for reading; a restart holds it exclusively while it
+	// replaces browser, page and pool, then bumps browserGen.
+	// relaunchFunc launches the replacement browser; replaceable for testing.
+	browserStateMu     sync.RWMutex
+	browserGen         atomic.Int64
+	browserRestarts    int
+	maxBrowserRestarts int
+	relaunchFunc       func() error
 
 	// Per-operation rate limiting.
 	// Search: ~30/min → 2s min. Profile: ~60/min → 1s min.
 	searchDelay  time.Duration
 	profileDelay time.Duration
+	jitterMin    time.Duration // random extra delay in [jitterMin, jitterMax) (see WithJitterRange)
+	jitterMax    time.Duration
 	lastSearch   time.Time
 	lastProfile  time.Time
 	searchMu     sync.Mutex
 	profileMu    sync.Mutex
 
-	// Session token.
-	msToken string
+	// rateLimiter replaces the delays above when set (see WithRateLimiter).
+	rateLimiter RateLimiter
+
+	// Session token, rotated by every response. Guarded by msTokenMu since
+	// concurrent helpers (BatchGetUsers, browser pool fetches) update it.
+	msToken   string
+	msTokenMu sync.RWMutex
+
+	// authCookieExpires holds the expiry of each authCookies entry, if known.
+	// The cookie jar does not expose expiry, so it is recorded in SetCookies.
+	// Guarded by authCookieMu: a QR login's Wait may set cookies while
+	// HealthCheck reads them.
+	authCookieExpires map[string]time.Time
+	authCookieMu      sync.RWMutex
+
+	// Static API params cache (see WithParamsCaching).
+	paramsCaching bool
+	paramsMu      sync.Mutex
+	cachedParams  url.Values
 
 	// Device fingerprint (generated once per Scraper instance).
 	deviceID string
+
+	// batchConcurrency bounds concurrent lookups in BatchGetUsers.
+	batchConcurrency int
+
+	// trendWeights tunes SortVideos(SortByTrendScore) (see WithTrendWeights).
+	trendWeights TrendWeights
+
+	// progressFunc is called after each page of a paginated listing (see
+	// WithProgressFunc).
+	progressFunc func(fetched, total int)
+
+	// statsEnrichment refreshes per-video stats in GetUserVideos (opt-in).
+	statsEnrichment bool
+
+	// businessToken enables Business API mode (see WithBusinessAPIMode).
+	businessToken string
+
+	// insecureTLS disables TLS certificate verification (see WithInsecureTLS).
+	insecureTLS bool
+
+	// customHeaders are sent on every HTTP request (see WithCustomHeaders).
+	customHeaders map[string]string
+
+	// captchaSolver answers captcha challenges (see WithCaptchaSolver).
+	captchaSolver CaptchaSolver
+
+	// Retry policy for doRequest (see WithRetry). Zero maxRetries disables it.
+	maxRetries     int
+	retryBaseDelay time.Duration
+
+	// lastRateLimit records the most recent 429 (see LastRateLimitInfo).
+	lastRateLimit RateLimitInfo
+	rateLimitMu   sync.Mutex
+
+	// Counters behind GetRateLimitStats.
+	throttleWaits     atomic.Int64
+	throttleWaitNanos atomic.Int64
+	rateLimitHits     atomic.Int64
+
+	// Counters behind Stats.
+	requestCount    atomic.Int64
+	bytesReceived   atomic.Int64
+	errorCount      atomic.Int64
+	browserSignings atomic.Int64
+
+	// log receives timing/diagnostic records; nil means slog.Default().
+	log *slog.Logger
+
+	// tracer records spans (see WithTracerProvider); nil disables tracing.
+	tracer tracer
+
+	// In-memory response cache (see WithCache, WithCacheSearch); nil when
+	// disabled.
+	cache       *responseCache
+	cacheSearch bool
+
+	// httpCacheDir enables the on-disk response cache (see WithHTTPCacheDir).
+	httpCacheDir string
+
+	// transportWrapper wraps the HTTP transport (see WithTransportWrapper).
+	transportWrapper func(http.RoundTripper) http.RoundTripper
+
+	// screenshotDir receives signing failure screenshots (see
+	// WithScreenshotOnError).
+	screenshotDir string
+
+	// Transport timeouts, reapplied whenever the transport is rebuilt.
+	dialTimeout         time.Duration
+	tlsHandshakeTimeout time.Duration
 }
 
+// Default HTTP timeouts (see WithTimeout, WithDialTimeout,
+// WithTLSHandshakeTimeout).
+const (
+	defaultClientTimeout       = 15 * time.Second
+	defaultDialTimeout         = 10 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
+)
+
 // defaultTransport returns an http.Transport optimized for scraping:
 // connection pooling, keep-alive, and TLS handshake caching.
 func defaultTransport() *http.Transport {
@@ -79,14 +210,36 @@ func defaultTransport() *http.Transport {
 		MaxIdleConns:        100,
 		MaxIdleConnsPerHost: 10,
 		IdleConnTimeout:     90 * time.Second,
-		TLSHandshakeTimeout: 10 * time.Second,
-		DialContext: (&net.Dialer{
-			Timeout:   10 * time.Second,
-			KeepAlive: 30 * time.Second,
-		}).DialContext,
+		TLSHandshakeTimeout: defaultTLSHandshakeTimeout,
+		DialContext:         newDialer(defaultDialTimeout).DialContext,
+	}
+}
+
+func newDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
 	}
 }
 
+// newTransport returns a defaultTransport with the Scraper's TLS and timeout
+// settings applied.
+func (s *Scraper) newTransport() *http.Transport {
+	t := defaultTransport()
+	t.TLSClientConfig = s.tlsConfig()
+	t.TLSHandshakeTimeout = s.tlsHandshakeTimeout
+	t.DialContext = newDialer(s.dialTimeout).DialContext
+	return t
+}
+
+// tlsConfig returns the TLS client config, or nil for Go's defaults.
+func (s *Scraper) tlsConfig() *tls.Config {
+	if !s.insecureTLS {
+		return nil
+	}
+	return &tls.Config{InsecureSkipVerify: true}
+}
+
 // New creates a Scraper with sensible defaults. The browser is not launched
 // until InitBrowser or Login is called.
 func New() *Scraper {
@@ -94,20 +247,89 @@ func New() *Scraper {
 	s := &Scraper{
 		client: &http.Client{
 			Jar:       jar,
-			Timeout:   15 * time.Second,
+			Timeout:   defaultClientTimeout,
 			Transport: defaultTransport(),
 		},
-		baseURL:      "https://www.tiktok.com",
-		userAgent:    defaultUserAgent,
-		searchDelay:  2 * time.Second,
-		profileDelay: 1 * time.Second,
-		deviceID:     generateDeviceID(),
+		baseURL:             "https://www.tiktok.com",
+		userAgent:           defaultUserAgent,
+		region:              defaultRegion,
+		language:            defaultLanguage,
+		timezone:            defaultTimezone,
+		searchDelay:         2 * time.Second,
+		profileDelay:        1 * time.Second,
+		jitterMax:           500 * time.Millisecond,
+		deviceID:            generateDeviceID(),
+		batchConcurrency:    defaultBatchConcurrency,
+		browserPoolSize:     1,
+		maxBrowserRestarts:  defaultMaxBrowserRestarts,
+		trendWeights:        DefaultTrendWeights,
+		dialTimeout:         defaultDialTimeout,
+		tlsHandshakeTimeout: defaultTLSHandshakeTimeout,
 	}
 	s.signFunc = s.signURL
 	s.fetchFunc = s.browserFetch
+	s.relaunchFunc = s.relaunchBrowser
 	return s
 }
 
+// Clone returns a new Scraper with the same configuration (proxy, user
+// agent, locale, delays, timeouts, retry policy, browser options, etc.) but
+// none of the session state: it has its own empty cookie jar, no browser, and
+// is not logged in. Hooks such as the rate limiter, captcha solver, progress
+// func and transport wrapper are shared with s. An enabled response cache
+// starts empty. Business API mode is kept: the access token is configuration,
+// not a session, and baseURL is useless without it.
+func (s *Scraper) Clone() *Scraper {
+	c := New()
+	c.client.Timeout = s.client.Timeout
+	c.proxy = s.proxy
+	c.userAgent = s.userAgent
+	c.region = s.region
+	c.language = s.language
+	c.timezone = s.timezone
+	c.baseURL = s.baseURL
+	c.businessToken = s.businessToken
+	c.deviceID = s.deviceID
+
+	c.browserPoolSize = s.browserPoolSize
+	c.browserExecPath = s.browserExecPath
+	c.browserFlags = slices.Clone(s.browserFlags)
+	c.headful = s.headful
+	c.maxBrowserRestarts = s.maxBrowserRestarts
+	c.screenshotDir = s.screenshotDir
+
+	c.searchDelay = s.searchDelay
+	c.profileDelay = s.profileDelay
+	c.jitterMin = s.jitterMin
+	c.jitterMax = s.jitterMax
+	c.rateLimiter = s.rateLimiter
+	c.maxRetries = s.maxRetries
+	c.retryBaseDelay = s.retryBaseDelay
+
+	c.paramsCaching = s.paramsCaching
+	c.batchConcurrency = s.batchConcurrency
+	c.trendWeights = s.trendWeights
+	c.progressFunc = s.progressFunc
+	c.statsEnrichment = s.statsEnrichment
+	c.customHeaders = maps.Clone(s.customHeaders)
+	c.captchaSolver = s.captchaSolver
+	c.log = s.log
+	c.tracer = s.tracer
+	if s.cache != nil {
+		c.cache = newResponseCache(s.cache.ttl, s.cache.maxSize)
+	}
+	c.cacheSearch = s.cacheSearch
+
+	// Transport settings, applied together by rebuilding the transport.
+	c.insecureTLS = s.insecureTLS
+	c.httpCacheDir = s.httpCacheDir
+	c.transportWrapper = s.transportWrapper
+	c.dialTimeout = s.dialTimeout
+	c.tlsHandshakeTimeout = s.tlsHandshakeTimeout
+	c.rebuildTransport()
+	return c
+}
+
 // generateDeviceID creates a random 19-digit device ID (mimics TikTok web).
 func generateDeviceID() string {
 	// 19-digit random number starting with 7 (matches TikTok pattern).
@@ -115,12 +337,44 @@ func generateDeviceID() string {
 	return strconv.FormatInt(id, 10)
 }
 
-// SetDebug enables performance timing output to stderr.
+// SetDebug enables performance timing output to stderr. It is a shortcut for
+// WithLogger with a Debug-level text logger on stderr; disabling it reverts
+// to slog.Default(). Either way it replaces a logger set with WithLogger.
 func (s *Scraper) SetDebug(enabled bool) *Scraper {
-	debugPerf = enabled
+	if !enabled {
+		s.log = nil
+		return s
+	}
+	s.log = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	return s
+}
+
+// WithLogger sets the logger for timing and diagnostic records, which are
+// emitted at Debug level with structured attributes ("op", "elapsed", ...).
+// A nil logger means slog.Default().
+func (s *Scraper) WithLogger(l *slog.Logger) *Scraper {
+	s.log = l
 	return s
 }
 
+// logger returns the Scraper's logger, falling back to slog.Default().
+func (s *Scraper) logger() *slog.Logger {
+	if s.log == nil {
+		return slog.Default()
+	}
+	return s.log
+}
+
+// logTiming emits a Debug record for a timed operation.
+func (s *Scraper) logTiming(ctx context.Context, op string, elapsed time.Duration, attrs ...slog.Attr) {
+	l := s.logger()
+	if !l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs = append([]slog.Attr{slog.String("op", op), slog.Duration("elapsed", elapsed)}, attrs...)
+	l.LogAttrs(ctx, slog.LevelDebug, "timing", attrs...)
+}
+
 // WithSearchDelay sets the minimum delay between search/hashtag API requests.
 func (s *Scraper) WithSearchDelay(d time.Duration) *Scraper {
 	s.searchDelay = d
@@ -133,11 +387,287 @@ func (s *Scraper) WithProfileDelay(d time.Duration) *Scraper {
 	return s
 }
 
-// SetProxy configures an HTTP/HTTPS or SOCKS5 proxy for the HTTP client.
+// WithJitterRange sets the random delay added to the search and profile
+// delays, drawn uniformly from [min, max). The default is 0–500ms; pass
+// 0, 0 for no jitter. Invalid ranges (min > max or negative) are ignored.
+func (s *Scraper) WithJitterRange(min, max time.Duration) *Scraper {
+	if min >= 0 && min <= max {
+		s.jitterMin = min
+		s.jitterMax = max
+	}
+	return s
+}
+
+// WithUserAgent overrides the User-Agent sent on HTTP requests and reported
+// as browser_version in API params. The Sec-Ch-Ua client hint follows the
+// Chrome version in ua, and is omitted for non-Chromium agents. An empty ua
+// is ignored.
+func (s *Scraper) WithUserAgent(ua string) *Scraper {
+	if ua == "" {
+		return s
+	}
+	s.userAgent = ua
+
+	// browser_version is a static param; drop any cached copy.
+	s.resetParamsCache()
+	return s
+}
+
+// WithRegion sets the region param sent with every API request (default
+// "US"), e.g. "BR" to see that region's search results. An empty region is
+// ignored. GetVideosByRegionHashtag overrides it for a single call.
+func (s *Scraper) WithRegion(region string) *Scraper {
+	if region == "" {
+		return s
+	}
+	s.region = region
+	s.resetParamsCache()
+	return s
+}
+
+// WithLanguage sets the language, app_language and webcast_language params
+// sent with every API request (default "en"). An empty lang is ignored.
+func (s *Scraper) WithLanguage(lang string) *Scraper {
+	if lang == "" {
+		return s
+	}
+	s.language = lang
+	s.resetParamsCache()
+	return s
+}
+
+// WithTimezone sets the tz_name param sent with every API request (default
+// "America/New_York"). An empty tz is ignored.
+func (s *Scraper) WithTimezone(tz string) *Scraper {
+	if tz == "" {
+		return s
+	}
+	s.timezone = tz
+	s.resetParamsCache()
+	return s
+}
+
+// resetParamsCache drops the cached static params after a field they are
+// built from changes.
+func (s *Scraper) resetParamsCache() {
+	s.paramsMu.Lock()
+	s.cachedParams = nil
+	s.paramsMu.Unlock()
+}
+
+// WithCustomHeaders sets extra headers sent on every HTTP request, e.g. a
+// session-specific X-Tt-Passport-Csrf-Token. They override the standard
+// headers but not per-call WithHeaders values, and replace any set by an
+// earlier call. Browser fetches are not affected.
+//
+// Overriding User-Agent here is deprecated: use WithUserAgent, which also
+// keeps the Sec-Ch-Ua hint and browser_version param consistent.
+func (s *Scraper) WithCustomHeaders(headers map[string]string) *Scraper {
+	s.customHeaders = maps.Clone(headers)
+	return s
+}
+
+// secChUa returns the Sec-Ch-Ua header value matching the Chrome version in
+// ua, or "" if ua is not a Chrome User-Agent.
+func secChUa(ua string) string {
+	m := chromeVersionRe.FindStringSubmatch(ua)
+	if m == nil {
+		return ""
+	}
+	return fmt.Sprintf(`"Google Chrome";v="%s", "Chromium";v="%s", "Not_A Brand";v="24"`, m[1], m[1])
+}
+
+// WithBatchConcurrency sets how many profiles BatchGetUsers fetches, and how
+// many keywords SearchMultipleKeywords searches, at once. Values below 1 are
+// ignored. Requests are still spaced by the profile or search delay.
+func (s *Scraper) WithBatchConcurrency(n int) *Scraper {
+	if n >= 1 {
+		s.batchConcurrency = n
+	}
+	return s
+}
+
+// WithTrendWeights sets the weights used by the Scraper's SortVideos when
+// ordering by SortByTrendScore.
+func (s *Scraper) WithTrendWeights(w TrendWeights) *Scraper {
+	s.trendWeights = w
+	return s
+}
+
+// WithStatsEnrichment makes GetUserVideos and GetUserVideosBySecUID refresh
+// each video's stats via GetVideoByID. Disabled by default because it doubles
+// the number of API calls.
+func (s *Scraper) WithStatsEnrichment(enabled bool) *Scraper {
+	s.statsEnrichment = enabled
+	return s
+}
+
+// WithBrowserPoolSize makes InitBrowser open n stealth pages so up to n
+// browser sign+fetch calls run concurrently instead of one at a time
+// (default 1). The pages share one browser and its cookies. Must be set
+// before InitBrowser or Login; values below 1 are ignored.
+func (s *Scraper) WithBrowserPoolSize(n int) *Scraper {
+	if n < 1 {
+		return s
+	}
+	s.browserPoolSize = n
+	return s
+}
+
+// WithBrowserExecutable launches the Chrome binary at path instead of the
+// one rod finds or downloads, e.g. "/usr/bin/chromium" in a container. Must
+// be set before InitBrowser or Login.
+func (s *Scraper) WithBrowserExecutable(path string) *Scraper {
+	s.browserExecPath = path
+	return s
+}
+
+// WithBrowserFlags adds Chrome command-line flags such as "--no-sandbox" or
+// "--window-size=1280,720". Repeated calls accumulate. Must be set before
+// InitBrowser or Login.
+func (s *Scraper) WithBrowserFlags(flags ...string) *Scraper {
+	s.browserFlags = append(s.browserFlags, flags...)
+	return s
+}
+
+// WithHeadful launches Chrome with a visible window and stops blocking
+// images and stylesheets, so the page renders as a user would see it. Meant
+// for debugging automation issues; it is slower and needs a display, so it
+// is unsuitable for production. Must be set before InitBrowser or Login.
+func (s *Scraper) WithHeadful(enabled bool) *Scraper {
+	s.headful = enabled
+	return s
+}
+
+// splitBrowserFlag splits "--name=v1,v2" into its name and values, the form
+// rod's launcher takes.
+func splitBrowserFlag(flag string) (name string, values []string) {
+	name, value, ok := strings.Cut(strings.TrimLeft(flag, "-"), "=")
+	if ok {
+		values = strings.Split(value, ",")
+	}
+	return name, values
+}
+
+// WithProgressFunc registers f to be called after each page fetched by
+// SearchVideos, SearchByHashtag, GetUserVideos, GetUserLikedVideos,
+// GetFeedVideos, and GetVideosByEffect, with the number of videos collected
+// so far and the requested limit (total is -1 if a listing has no known
+// target). SearchMultipleKeywords reports its combined count and serializes
+// the calls. f runs on the fetching goroutine, so it needs no locking unless
+// the Scraper is shared across goroutines; it should return quickly.
+func (s *Scraper) WithProgressFunc(f func(fetched, total int)) *Scraper {
+	s.progressFunc = f
+	return s
+}
+
+// reportProgress calls the WithProgressFunc callback, if any. The last page
+// can overshoot the limit before truncation, so fetched is capped at total.
+func (s *Scraper) reportProgress(fetched, total int) {
+	if s.progressFunc == nil {
+		return
+	}
+	if total >= 0 {
+		fetched = min(fetched, total)
+	}
+	s.progressFunc(fetched, total)
+}
+
+// WithParamsCaching caches the static API query params so only the per-request
+// fields (history_len, msToken) are rebuilt. Useful for batch scraping.
+func (s *Scraper) WithParamsCaching() *Scraper {
+	s.paramsCaching = true
+	return s
+}
+
+// WithInsecureTLS disables TLS certificate verification on the HTTP client.
+// Intended for proxies with self-signed certificates. Go applies the same TLS
+// config to the proxy and the target, so this also skips verification of
+// tiktok.com. Never enable it in production.
+func (s *Scraper) WithInsecureTLS(enabled bool) *Scraper {
+	s.insecureTLS = enabled
+	s.rebuildTransport()
+	return s
+}
+
+// WithTimeout sets the overall HTTP client timeout per request, including
+// reading the body (default 15s). Zero means no timeout.
+func (s *Scraper) WithTimeout(d time.Duration) *Scraper {
+	s.client.Timeout = d
+	return s
+}
+
+// WithHTTPClient replaces the Scraper's HTTP client, e.g. to share one
+// connection pool across scrapers or to use a custom transport. The caller
+// must supply a cookie jar, since the session lives in it; WithHTTPClient
+// panics if c.Jar is nil. Options applied afterwards that rebuild the
+// transport (SetProxy, WithInsecureTLS, WithDialTimeout,
+// WithTLSHandshakeTimeout, WithHTTPCacheDir) overwrite c.Transport, so
+// configure a shared client's transport on the client itself.
+func (s *Scraper) WithHTTPClient(c *http.Client) *Scraper {
+	if c == nil || c.Jar == nil {
+		panic("tiktok: WithHTTPClient requires an http.Client with a non-nil cookie jar")
+	}
+	s.client = c
+	return s
+}
+
+// WithDialTimeout sets the TCP connect timeout, including connecting to a
+// SOCKS5 proxy (default 10s). Zero means no timeout.
+func (s *Scraper) WithDialTimeout(d time.Duration) *Scraper {
+	s.dialTimeout = d
+	s.rebuildTransport()
+	return s
+}
+
+// WithTLSHandshakeTimeout sets the TLS handshake timeout (default 10s). Zero
+// means no timeout.
+func (s *Scraper) WithTLSHandshakeTimeout(d time.Duration) *Scraper {
+	s.tlsHandshakeTimeout = d
+	s.rebuildTransport()
+	return s
+}
+
+// WithTransportWrapper installs middleware around the HTTP client's
+// transport, e.g. request logging or metrics. wrap receives the transport
+// built from the proxy, timeout and cache settings and is re-applied
+// whenever that transport is rebuilt (SetProxy, WithDialTimeout, ...), so
+// it may be set before or after them. A later call replaces the wrapper;
+// nil removes it. Only HTTP client requests pass through it, not browser
+// fetches.
+func (s *Scraper) WithTransportWrapper(wrap func(http.RoundTripper) http.RoundTripper) *Scraper {
+	s.transportWrapper = wrap
+	s.rebuildTransport()
+	return s
+}
+
+// rebuildTransport recreates the HTTP transport so changed settings take
+// effect, keeping the current proxy. The proxy was validated when it was set,
+// so SetProxy cannot fail here.
+func (s *Scraper) rebuildTransport() {
+	_ = s.SetProxy(s.proxy)
+}
+
+// setTransport installs t as the HTTP client's transport, wrapped by the
+// response cache when WithHTTPCacheDir is set and then by the
+// WithTransportWrapper middleware.
+func (s *Scraper) setTransport(t *http.Transport) {
+	var rt http.RoundTripper = t
+	if s.httpCacheDir != "" {
+		rt = &cacheTransport{dir: s.httpCacheDir, next: t, logger: s.logger}
+	}
+	if s.transportWrapper != nil {
+		rt = s.transportWrapper(rt)
+	}
+	s.client.Transport = rt
+}
+
+// SetProxy configures an HTTP/HTTPS, SOCKS5 or SOCKS4 proxy for the HTTP
+// client. For socks4:// the URL's username is sent as the SOCKS4 user ID.
 // Connection pooling and keep-alive settings are preserved.
 func (s *Scraper) SetProxy(proxyAddr string) error {
 	if proxyAddr == "" {
-		s.client.Transport = defaultTransport()
+		s.setTransport(s.newTransport())
 		s.proxy = ""
 		return nil
 	}
@@ -147,19 +677,19 @@ func (s *Scraper) SetProxy(proxyAddr string) error {
 		return fmt.Errorf("parse proxy url: %w", err)
 	}
 
-	base := defaultTransport()
+	base := s.newTransport()
 
 	switch u.Scheme {
 	case "http", "https":
 		base.Proxy = http.ProxyURL(u)
-		s.client.Transport = base
+		s.setTransport(base)
 	case "socks5":
 		var auth *proxy.Auth
 		if u.User != nil {
 			pass, _ := u.User.Password()
 			auth = &proxy.Auth{User: u.User.Username(), Password: pass}
 		}
-		dialer, err := proxy.SOCKS5("tcp", u.Host, auth, proxy.Direct)
+		dialer, err := proxy.SOCKS5("tcp", u.Host, auth, newDialer(s.dialTimeout))
 		if err != nil {
 			return fmt.Errorf("socks5 proxy: %w", err)
 		}
@@ -168,7 +698,11 @@ func (s *Scraper) SetProxy(proxyAddr string) error {
 			return fmt.Errorf("socks5: context dialer not supported")
 		}
 		base.DialContext = dc.DialContext
-		s.client.Transport = base
+		s.setTransport(base)
+	case "socks4":
+		d := &socks4Dialer{proxyAddr: u.Host, userID: u.User.Username(), forward: newDialer(s.dialTimeout)}
+		base.DialContext = d.DialContext
+		s.setTransport(base)
 	default:
 		return fmt.Errorf("unsupported proxy scheme: %s", u.Scheme)
 	}
@@ -180,9 +714,39 @@ func (s *Scraper) SetProxy(proxyAddr string) error {
 // buildAPIParams returns the base query parameters required by TikTok's web API.
 // These mimic a real Chrome browser session and are appended to every API request.
 func (s *Scraper) buildAPIParams() url.Values {
+	start := time.Now()
+
+	p := s.staticAPIParams()
+	p.Set("history_len", strconv.Itoa(2+rand.IntN(8)))
+	if token := s.currentMsToken(); token != "" && s.businessToken == "" {
+		p.Set("msToken", token)
+	}
+
+	s.logTiming(context.Background(), "buildAPIParams", time.Since(start),
+		slog.Int("params", len(p)), slog.Bool("cached", s.paramsCaching))
+	return p
+}
+
+// staticAPIParams returns the params that do not change between requests,
+// served from a cached copy when WithParamsCaching is enabled.
+func (s *Scraper) staticAPIParams() url.Values {
+	if !s.paramsCaching {
+		return s.newStaticAPIParams()
+	}
+
+	s.paramsMu.Lock()
+	defer s.paramsMu.Unlock()
+	if s.cachedParams == nil {
+		s.cachedParams = s.newStaticAPIParams()
+	}
+	// Shallow clone is safe: callers only use Set, which replaces the slice.
+	return maps.Clone(s.cachedParams)
+}
+
+func (s *Scraper) newStaticAPIParams() url.Values {
 	p := url.Values{}
 	p.Set("aid", "1988")
-	p.Set("app_language", "en")
+	p.Set("app_language", s.language)
 	p.Set("app_name", "tiktok_web")
 	p.Set("browser_language", "en-US")
 	p.Set("browser_name", "Mozilla")
@@ -190,58 +754,166 @@ func (s *Scraper) buildAPIParams() url.Values {
 	p.Set("browser_platform", "MacIntel")
 	p.Set("browser_version", s.userAgent)
 	p.Set("channel", "tiktok_web")
-	p.Set("cookie_enabled", "true")
-	p.Set("device_id", s.deviceID)
+	if s.businessToken == "" {
+		// Cookie/session-derived fields; the Business API uses token auth.
+		p.Set("cookie_enabled", "true")
+		p.Set("device_id", s.deviceID)
+	}
 	p.Set("device_platform", "web_pc")
 	p.Set("focus_state", "true")
-	p.Set("history_len", strconv.Itoa(2+rand.IntN(8)))
 	p.Set("is_fullscreen", "false")
 	p.Set("is_page_visible", "true")
-	p.Set("language", "en")
+	p.Set("language", s.language)
 	p.Set("os", "mac")
 	p.Set("priority_region", "")
 	p.Set("referer", "")
-	p.Set("region", "US")
+	p.Set("region", s.region)
 	p.Set("screen_height", "1080")
 	p.Set("screen_width", "1920")
-	p.Set("tz_name", "America/New_York")
-	p.Set("webcast_language", "en")
-	if s.msToken != "" {
-		p.Set("msToken", s.msToken)
-	}
+	p.Set("tz_name", s.timezone)
+	p.Set("webcast_language", s.language)
 	return p
 }
 
+// headerKey is the context key for per-request headers set by WithHeaders.
+type headerKey struct{}
+
+// WithHeaders returns a context carrying extra HTTP headers to send on every
+// request made with it, e.g. a trace ID in X-Request-ID. They are merged with
+// headers from any parent WithHeaders call and override the Scraper's
+// standard headers. Only requests made via the HTTP client are affected, not
+// browser fetches.
+func WithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	merged := maps.Clone(headersFromContext(ctx))
+	if merged == nil {
+		merged = make(map[string]string, len(headers))
+	}
+	maps.Copy(merged, headers)
+	return context.WithValue(ctx, headerKey{}, merged)
+}
+
+func headersFromContext(ctx context.Context) map[string]string {
+	h, _ := ctx.Value(headerKey{}).(map[string]string)
+	return h
+}
+
 // doRequest builds and executes an HTTP request with standard TikTok headers.
+// With WithRetry configured, 429 and 5xx responses are retried with backoff.
 // No built-in rate limiting — callers use waitForSearch or waitForProfile.
-func (s *Scraper) doRequest(ctx context.Context, method, urlStr string, body io.Reader) (*http.Response, error) {
+func (s *Scraper) doRequest(ctx context.Context, method, urlStr string, body io.Reader) (_ *http.Response, err error) {
+	ctx, span := s.startSpan(ctx, "tiktok.doRequest")
+	span.setAttr("http.method", method)
+	span.setAttr("url", spanURL(urlStr))
+	defer func() {
+		if err != nil {
+			s.errorCount.Add(1)
+		}
+		span.end(err)
+	}()
+
+	// Buffer the body so it can be replayed on retries.
+	var payload []byte
+	if body != nil {
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := s.sendRequest(ctx, method, urlStr, payload)
+		if err != nil {
+			return nil, err
+		}
+		span.setAttr("http.status_code", resp.StatusCode)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			s.recordRateLimit(resp.Header)
+		}
+		if s.maxRetries == 0 || !isRetryableStatus(resp.StatusCode) {
+			return checkStatus(resp)
+		}
+		if err := s.waitToRetry(ctx, attempt, resp); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitToRetry discards resp, a retryable failure on the given zero-based
+// attempt, and sleeps for the backoff delay. It returns
+// ErrMaxRetriesExceeded once the retries are used up.
+func (s *Scraper) waitToRetry(ctx context.Context, attempt int, resp *http.Response) error {
+	resp.Body.Close()
+	if attempt == s.maxRetries {
+		lastErr := retryableStatusError(resp.StatusCode)
+		return fmt.Errorf("%w after %d attempts: %w", ErrMaxRetriesExceeded, attempt+1, lastErr)
+	}
+	delay := s.retryDelay(attempt, resp.Header)
+	s.logger().LogAttrs(ctx, slog.LevelDebug, "retrying request",
+		slog.Int("attempt", attempt+1), slog.Int("status", resp.StatusCode), slog.Duration("delay", delay))
+	if err := sleepContext(ctx, delay); err != nil {
+		return fmt.Errorf("retry wait: %w", err)
+	}
+	return nil
+}
+
+// sendRequest makes a single HTTP request with standard TikTok headers and
+// returns the response whatever its status.
+func (s *Scraper) sendRequest(ctx context.Context, method, urlStr string, payload []byte) (*http.Response, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
 	req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
+	s.setStandardHeaders(req)
+
+	// Scraper-wide custom headers, then per-call headers from the context,
+	// override the defaults.
+	for k, v := range s.customHeaders {
+		req.Header.Set(k, v)
+	}
+	for k, v := range headersFromContext(ctx) {
+		req.Header.Set(k, v)
+	}
+
+	s.requestCount.Add(1)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	resp.Body = countingBody{resp.Body, &s.bytesReceived}
 
+	// Capture fresh msToken from response — TikTok rotates it per request.
+	s.extractMsToken(resp)
+	return resp, nil
+}
+
+func (s *Scraper) setStandardHeaders(req *http.Request) {
 	req.Header.Set("User-Agent", s.userAgent)
 	req.Header.Set("Accept", "application/json, text/plain, */*")
 	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
 	req.Header.Set("Referer", "https://www.tiktok.com/")
 	req.Header.Set("Origin", "https://www.tiktok.com")
 
-	// Chrome client hints — anti-bot systems check for these.
-	req.Header.Set("Sec-Ch-Ua", `"Google Chrome";v="131", "Chromium";v="131", "Not_A Brand";v="24"`)
-	req.Header.Set("Sec-Ch-Ua-Mobile", "?0")
-	req.Header.Set("Sec-Ch-Ua-Platform", `"macOS"`)
+	// Chrome client hints — anti-bot systems check for these, and for them
+	// matching the User-Agent. Non-Chromium browsers don't send them.
+	if chUa := secChUa(s.userAgent); chUa != "" {
+		req.Header.Set("Sec-Ch-Ua", chUa)
+		req.Header.Set("Sec-Ch-Ua-Mobile", "?0")
+		req.Header.Set("Sec-Ch-Ua-Platform", `"macOS"`)
+	}
 	req.Header.Set("Sec-Fetch-Dest", "empty")
 	req.Header.Set("Sec-Fetch-Mode", "cors")
 	req.Header.Set("Sec-Fetch-Site", "same-origin")
 
-	resp, err := s.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do request: %w", err)
+	if s.isBusinessAPIRequest(req) {
+		req.Header.Set("Authorization", "Bearer "+s.businessToken)
 	}
+}
 
-	// Capture fresh msToken from response — TikTok rotates it per request.
-	s.extractMsToken(resp)
-
+// checkStatus maps status codes with a sentinel error, closing the body.
+func checkStatus(resp *http.Response) (*http.Response, error) {
 	switch resp.StatusCode {
 	case http.StatusTooManyRequests:
 		resp.Body.Close()
@@ -250,7 +922,6 @@ func (s *Scraper) doRequest(ctx context.Context, method, urlStr string, body io.
 		resp.Body.Close()
 		return nil, ErrNotFound
 	}
-
 	return resp, nil
 }
 
@@ -259,31 +930,53 @@ func (s *Scraper) doRequest(ctx context.Context, method, urlStr string, body io.
 func (s *Scraper) extractMsToken(resp *http.Response) {
 	// Prefer X-Ms-Token header (always present when token rotates).
 	if token := resp.Header.Get("X-Ms-Token"); token != "" {
-		s.msToken = token
+		s.setMsToken(token)
 		return
 	}
 
 	// Fallback: extract from Set-Cookie.
 	for _, c := range resp.Cookies() {
 		if c.Name == "msToken" {
-			s.msToken = c.Value
+			s.setMsToken(c.Value)
 			return
 		}
 	}
 }
 
-// waitForSearch enforces rate limiting for search/hashtag API calls.
-func (s *Scraper) waitForSearch() {
+func (s *Scraper) currentMsToken() string {
+	s.msTokenMu.RLock()
+	defer s.msTokenMu.RUnlock()
+	return s.msToken
+}
+
+func (s *Scraper) setMsToken(token string) {
+	s.msTokenMu.Lock()
+	defer s.msTokenMu.Unlock()
+	s.msToken = token
+}
+
+// waitForSearch enforces rate limiting for search/hashtag API calls. It
+// only fails when a custom RateLimiter does (e.g. ctx is done).
+func (s *Scraper) waitForSearch(ctx context.Context) error {
+	if s.rateLimiter != nil {
+		return s.rateLimiter.Wait(ctx)
+	}
 	s.searchMu.Lock()
 	defer s.searchMu.Unlock()
 	s.throttle(&s.lastSearch, s.searchDelay)
+	return nil
 }
 
-// waitForProfile enforces rate limiting for user profile lookups.
-func (s *Scraper) waitForProfile() {
+// waitForProfile enforces rate limiting for user profile lookups. It only
+// fails when a custom RateLimiter does (e.g. ctx is done).
+func (s *Scraper) waitForProfile(ctx context.Context) error {
+	if s.rateLimiter != nil {
+		return s.rateLimiter.Wait(ctx)
+	}
 	s.profileMu.Lock()
 	defer s.profileMu.Unlock()
 	s.throttle(&s.lastProfile, s.profileDelay)
+	return nil
 }
 
 // throttle sleeps if needed to enforce min delay + jitter between requests.
@@ -296,18 +989,27 @@ func (s *Scraper) throttle(lastReq *time.Time, delay time.Duration) {
 	// First call — no previous request, skip the wait.
 	if lastReq.IsZero() {
 		*lastReq = start
-		perfLog("throttle: first_call delay=%v skipped=true", delay)
+		s.logTiming(context.Background(), "throttle", 0, slog.Duration("delay", delay), slog.Bool("skipped", true))
 		return
 	}
 
 	elapsed := start.Sub(*lastReq)
-	jitter := time.Duration(rand.Int64N(int64(500 * time.Millisecond)))
+	jitter := s.jitterMin
+	if s.jitterMax > s.jitterMin {
+		jitter += time.Duration(rand.Int64N(int64(s.jitterMax - s.jitterMin)))
+	}
 	wait := delay + jitter - elapsed
 	if wait > 0 {
 		time.Sleep(wait)
+		s.throttleWaits.Add(1)
+		s.throttleWaitNanos.Add(int64(time.Since(start)))
 	}
 	*lastReq = time.Now()
-	perfLog("throttle: delay=%v jitter=%v elapsed=%v slept=%v", delay, jitter, elapsed, time.Since(start))
+	stats := s.GetRateLimitStats()
+	s.logTiming(context.Background(), "throttle", time.Since(start),
+		slog.Duration("delay", delay), slog.Duration("jitter", jitter), slog.Duration("since_last", elapsed),
+		slog.Int64("total_waits", stats.TotalWaits), slog.Duration("total_wait", stats.TotalWaitDuration),
+		slog.Int64("rate_limit_hits", stats.RateLimitHits))
 }
 
 // GetCookies returns the current session cookies for tiktok.com.
@@ -319,8 +1021,11 @@ func (s *Scraper) GetCookies() []*http.Cookie {
 func (s *Scraper) SetCookies(cookies []*http.Cookie) {
 	s.client.Jar.SetCookies(tiktokURL, cookies)
 	for _, c := range cookies {
-		if c.Name == "msToken" {
-			s.msToken = c.Value
+		switch {
+		case c.Name == "msToken":
+			s.setMsToken(c.Value)
+		case slices.Contains(authCookies, c.Name):
+			s.recordAuthCookieExpiry(c)
 		}
 	}
 }
@@ -335,6 +1040,8 @@ func (s *Scraper) SaveCookies(path string) error {
 }
 
 // LoadCookies reads cookies from a JSON file and sets them on the client.
+// Returns ErrCookiesExpired, without loading anything, when every
+// authentication cookie in the file has expired.
 func (s *Scraper) LoadCookies(path string) error {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -344,11 +1051,30 @@ func (s *Scraper) LoadCookies(path string) error {
 	if err := json.Unmarshal(data, &cookies); err != nil {
 		return fmt.Errorf("unmarshal cookies: %w", err)
 	}
+	if authCookiesExpired(cookies, time.Now()) {
+		return fmt.Errorf("load cookies %s: %w", path, ErrCookiesExpired)
+	}
 	s.SetCookies(cookies)
 	s.isLogged = true
 	return nil
 }
 
+// CheckConnection fetches the TikTok homepage through the HTTP client (and
+// proxy, if configured) and reports the round-trip latency. A non-2xx
+// response, such as a proxy's 407 or a block page, is an ErrInvalidResponse.
+func (s *Scraper) CheckConnection(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	resp, err := s.doRequest(ctx, "GET", s.baseURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("check connection: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, fmt.Errorf("check connection: %w: http %d", ErrInvalidResponse, resp.StatusCode)
+	}
+	return time.Since(start), nil
+}
+
 // IsLoggedIn reports whether the scraper has an active session.
 func (s *Scraper) IsLoggedIn() bool {
 	return s.isLogged
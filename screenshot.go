@@ -0,0 +1,44 @@
+package tiktok
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrWithScreenshot wraps a browser failure with the path of a screenshot
+// of the page taken when it happened (see WithScreenshotOnError).
+// errors.Is and errors.As see through it to Err.
+type ErrWithScreenshot struct {
+	Err  error
+	Path string
+}
+
+func (e *ErrWithScreenshot) Error() string {
+	return fmt.Sprintf("%v (screenshot: %s)", e.Err, e.Path)
+}
+
+func (e *ErrWithScreenshot) Unwrap() error { return e.Err }
+
+// WithScreenshotOnError saves a PNG of the signing page to dir whenever URL
+// signing fails, named <timestamp>_signing_failure.png. The returned error
+// is then an *ErrWithScreenshot carrying the path. dir is created if
+// missing; an empty dir disables screenshots (the default).
+func (s *Scraper) WithScreenshotOnError(dir string) *Scraper {
+	s.screenshotDir = dir
+	return s
+}
+
+// saveScreenshot writes png to dir under a timestamped name and returns
+// its path.
+func saveScreenshot(dir string, png []byte, now time.Time) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("save screenshot: %w", err)
+	}
+	path := filepath.Join(dir, now.Format("20060102T150405.000000000")+"_signing_failure.png")
+	if err := os.WriteFile(path, png, 0o644); err != nil {
+		return "", fmt.Errorf("save screenshot: %w", err)
+	}
+	return path, nil
+}
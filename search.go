@@ -3,40 +3,89 @@ package tiktok
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strconv"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 )
 
 // browserAPIRequest builds the full API URL and fetches it via the browser.
 // The browser signs the URL (X-Bogus) and makes the HTTP request itself,
 // ensuring the TLS fingerprint, cookies, and session are all consistent.
+// In Business API mode the browser is bypassed (see fetchAPI). With
+// WithCacheSearch, search and hashtag responses go through the in-memory
+// cache.
 func (s *Scraper) browserAPIRequest(
-	_ context.Context,
+	ctx context.Context,
 	path string,
 	setParams func(p map[string]string),
-) ([]byte, error) {
+) (body []byte, err error) {
 	totalStart := time.Now()
+	ctx, span := s.startSpan(ctx, "tiktok.browserAPIRequest")
+	defer func() {
+		span.setAttr("response.bytes", len(body))
+		span.end(err)
+	}()
 
 	params := s.buildAPIParams()
 
-	// Apply caller-specific params.
+	// Apply caller-specific params, then any call-scoped overrides.
 	extra := make(map[string]string)
 	setParams(extra)
+	maps.Copy(extra, paramOverridesFromContext(ctx))
 	for k, v := range extra {
 		params.Set(k, v)
 	}
 
 	rawURL := s.baseURL + path + "?" + params.Encode()
 	buildDur := time.Since(totalStart)
+	span.setAttr("url", spanURL(rawURL))
+	if cursor := params.Get("cursor"); cursor != "" {
+		span.setAttr("cursor", cursor)
+	}
+
+	if !s.cacheSearch || !isSearchCachePath(path) {
+		return s.fetchAPIBody(ctx, path, rawURL, buildDur)
+	}
+	key := s.baseURL + path + "?" + stableQuery(params)
+	if cached, ok := s.cache.get(key); ok {
+		return cached, nil
+	}
+	body, err = s.fetchAPIBody(ctx, path, rawURL, buildDur)
+	if err == nil {
+		s.cache.put(key, body)
+	}
+	return body, err
+}
+
+// fetchAPIBody fetches rawURL via fetchAPI and rejects empty or HTML bodies.
+// A captcha challenge is retried once with a token from the configured
+// CaptchaSolver, if any.
+func (s *Scraper) fetchAPIBody(ctx context.Context, path, rawURL string, buildDur time.Duration) ([]byte, error) {
+	body, err := s.fetchAPIBodyOnce(ctx, path, rawURL, buildDur)
+	var ce *captchaError
+	if s.captchaSolver == nil || !errors.As(err, &ce) {
+		return body, err
+	}
+	retryURL, err := s.solveCaptcha(ctx, rawURL, ce)
+	if err != nil {
+		return nil, err
+	}
+	return s.fetchAPIBodyOnce(ctx, path, retryURL, 0)
+}
 
+func (s *Scraper) fetchAPIBodyOnce(ctx context.Context, path, rawURL string, buildDur time.Duration) ([]byte, error) {
 	fetchStart := time.Now()
-	s.browserMu.Lock()
-	body, err := s.fetchFunc(rawURL)
-	s.browserMu.Unlock()
+	body, err := s.fetchAPI(ctx, rawURL)
 	fetchDur := time.Since(fetchStart)
 
-	perfLog("browserAPIRequest: path=%s build=%v fetch=%v total=%v", path, buildDur, fetchDur, time.Since(totalStart))
+	s.logTiming(ctx, "browserAPIRequest", buildDur+fetchDur,
+		slog.String("path", path), slog.Duration("build", buildDur), slog.Duration("fetch", fetchDur))
 
 	if err != nil {
 		return nil, fmt.Errorf("browser fetch: %w", err)
@@ -45,28 +94,131 @@ func (s *Scraper) browserAPIRequest(
 	if len(body) == 0 {
 		return nil, fmt.Errorf("%w: empty response", ErrInvalidResponse)
 	}
+	if err := detectCaptcha(rawURL, body); err != nil {
+		return nil, err
+	}
+	// An HTML body means TikTok served an error or redirect page instead of JSON.
+	if body[0] == '<' {
+		return nil, fmt.Errorf("%w: got html instead of json: %s", ErrInvalidResponse, truncateBody(body, 200))
+	}
 	return body, nil
 }
 
+// fetchAPI fetches a built API URL. In Business API mode the request goes
+// straight through the HTTP client with token auth; otherwise the browser
+// signs and fetches it, on a pooled page if WithBrowserPoolSize is set, and
+// is restarted if it has crashed.
+func (s *Scraper) fetchAPI(ctx context.Context, rawURL string) ([]byte, error) {
+	if s.businessToken != "" {
+		return s.httpFetch(ctx, rawURL)
+	}
+	return s.fetchWithRecovery(ctx, rawURL)
+}
+
+// fetchOnPage signs and fetches rawURL on s.page or a pooled page.
+func (s *Scraper) fetchOnPage(ctx context.Context, rawURL string) ([]byte, error) {
+	s.browserStateMu.RLock()
+	defer s.browserStateMu.RUnlock()
+
+	if s.pool == nil {
+		s.browserMu.Lock()
+		defer s.browserMu.Unlock()
+		return s.fetchFunc(s.page, rawURL)
+	}
+
+	page, err := s.pool.get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("wait for browser page: %w", err)
+	}
+	defer s.pool.put(page)
+	return s.fetchFunc(page, rawURL)
+}
+
+// truncateBody returns at most n bytes of body as a string for error messages.
+func truncateBody(body []byte, n int) string {
+	if len(body) <= n {
+		return string(body)
+	}
+	return string(body[:n]) + "..."
+}
+
 // SearchVideos searches TikTok for videos matching the keyword.
 // Requires an initialized browser (InitBrowser) and authentication.
 func (s *Scraper) SearchVideos(ctx context.Context, keyword string, limit int) ([]Video, error) {
+	progress := func(n int) { s.reportProgress(n, limit) }
+	return s.runSearch(ctx, "tiktok.SearchVideos", keyword, limit, nil, progress)
+}
+
+// SearchOptions narrows SearchVideosWithOptions results. Zero-valued fields
+// do not filter. Durations are in seconds.
+type SearchOptions struct {
+	Limit int // Number of matching videos to return.
+
+	MinViews, MaxViews       int64
+	After, Before            time.Time // Bounds on CreatedAt, exclusive.
+	MinDuration, MaxDuration int
+	OnlyVerified             bool // Keep only videos by verified authors.
+}
+
+// filters returns the VideoFilters for the set fields.
+func (o SearchOptions) filters() []VideoFilter {
+	var fs []VideoFilter
+	add := func(set bool, f VideoFilter) {
+		if set {
+			fs = append(fs, f)
+		}
+	}
+	add(o.MinViews > 0, func(v Video) bool { return v.Views >= o.MinViews })
+	add(o.MaxViews > 0, func(v Video) bool { return v.Views <= o.MaxViews })
+	add(!o.After.IsZero(), func(v Video) bool { return v.CreatedAt.After(o.After) })
+	add(!o.Before.IsZero(), func(v Video) bool { return v.CreatedAt.Before(o.Before) })
+	add(o.MinDuration > 0, func(v Video) bool { return v.Duration >= o.MinDuration })
+	add(o.MaxDuration > 0, func(v Video) bool { return v.Duration <= o.MaxDuration })
+	add(o.OnlyVerified, func(v Video) bool { return v.AuthorVerified })
+	return fs
+}
+
+// SearchVideosWithOptions is SearchVideos with result filters. TikTok has no
+// server-side filter params, so pages are filtered as they arrive and
+// pagination continues until opts.Limit videos match or results run out;
+// narrow filters can cost many requests. Requires an initialized browser
+// (InitBrowser) and authentication.
+func (s *Scraper) SearchVideosWithOptions(ctx context.Context, keyword string, opts SearchOptions) ([]Video, error) {
+	progress := func(n int) { s.reportProgress(n, opts.Limit) }
+	return s.runSearch(ctx, "tiktok.SearchVideosWithOptions", keyword, opts.Limit, opts.filters(), progress)
+}
+
+// runSearch validates keyword and runs searchVideos in a span named name.
+func (s *Scraper) runSearch(ctx context.Context, name, keyword string, limit int, filters []VideoFilter, progress func(kept int)) (_ []Video, err error) {
 	if keyword == "" {
 		return nil, fmt.Errorf("search videos: keyword is required")
 	}
+	ctx, span := s.startSpan(ctx, name)
+	span.setAttr("keyword", keyword)
+	defer func() { span.end(err) }()
 
+	return s.searchVideos(ctx, keyword, limit, filters, progress)
+}
+
+// searchVideos pages through search results, keeping videos that pass every
+// filter, until limit videos are kept. progress is called with the kept
+// count after each page.
+func (s *Scraper) searchVideos(ctx context.Context, keyword string, limit int, filters []VideoFilter, progress func(kept int)) ([]Video, error) {
 	var allVideos []Video
-	cursor := 0
+	var cursor Cursor
 
 	for len(allVideos) < limit {
-		s.waitForSearch()
+		if err := s.waitForSearch(ctx); err != nil {
+			return allVideos, fmt.Errorf("search videos %q: %w", keyword, err)
+		}
 
 		videos, nextCursor, err := s.fetchSearch(ctx, keyword, cursor)
 		if err != nil {
 			return allVideos, fmt.Errorf("search videos %q: %w", keyword, err)
 		}
-		allVideos = append(allVideos, videos...)
-		if nextCursor == 0 {
+		allVideos = append(allVideos, FilterVideos(videos, filters...)...)
+		progress(len(allVideos))
+		if nextCursor.IsZero() {
 			break
 		}
 		cursor = nextCursor
@@ -78,20 +230,102 @@ func (s *Scraper) SearchVideos(ctx context.Context, keyword string, limit int) (
 	return allVideos, nil
 }
 
-func (s *Scraper) fetchSearch(ctx context.Context, keyword string, cursor int) ([]Video, int, error) {
+// SearchMode selects how SearchByKeywords combines keywords.
+type SearchMode int
+
+const (
+	// SearchModeAny returns videos matching any keyword (OR).
+	SearchModeAny SearchMode = iota
+	// SearchModeAll returns videos matching every keyword (AND), filtered
+	// client-side.
+	SearchModeAll
+)
+
+// SearchByKeywords searches for several keywords at once. With SearchModeAny
+// it runs one SearchVideos per keyword, merges the results without duplicates
+// and truncates to limit; each keyword costs its own search requests, so
+// expect roughly len(keywords) times the rate-limit delay. With SearchModeAll
+// it searches the first keyword only and keeps videos that match every other
+// keyword, case-insensitively, in their description or hashtags (a leading
+// '#' on a keyword is ignored for hashtags), so it may return fewer than
+// limit videos.
+func (s *Scraper) SearchByKeywords(ctx context.Context, keywords []string, limit int, mode SearchMode) ([]Video, error) {
+	if len(keywords) == 0 || slices.Contains(keywords, "") {
+		return nil, fmt.Errorf("search by keywords: %w: keywords must be non-empty", ErrInvalidInput)
+	}
+	if mode == SearchModeAll {
+		return s.searchAllKeywords(ctx, keywords, limit)
+	}
+
+	var merged []Video
+	seen := make(map[string]bool)
+	for _, kw := range keywords {
+		videos, err := s.SearchVideos(ctx, kw, limit)
+		if err != nil {
+			return merged, fmt.Errorf("search by keywords: %w", err)
+		}
+		for _, v := range videos {
+			if !seen[v.ID] {
+				seen[v.ID] = true
+				merged = append(merged, v)
+			}
+		}
+	}
+
+	if len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged, nil
+}
+
+func (s *Scraper) searchAllKeywords(ctx context.Context, keywords []string, limit int) ([]Video, error) {
+	videos, err := s.SearchVideos(ctx, keywords[0], limit)
+	if err != nil {
+		return nil, fmt.Errorf("search by keywords: %w", err)
+	}
+	return FilterVideos(videos, func(v Video) bool {
+		for _, kw := range keywords[1:] {
+			if !videoMatchesKeyword(v, kw) {
+				return false
+			}
+		}
+		return true
+	}), nil
+}
+
+// videoMatchesKeyword reports whether kw appears in v's description or in
+// one of its hashtags, ignoring case.
+func videoMatchesKeyword(v Video, kw string) bool {
+	kw = strings.ToLower(kw)
+	if strings.Contains(strings.ToLower(v.Description), kw) {
+		return true
+	}
+	tag := strings.TrimPrefix(kw, "#")
+	return slices.ContainsFunc(v.Hashtags, func(h string) bool {
+		return strings.Contains(strings.ToLower(h), tag)
+	})
+}
+
+func (s *Scraper) fetchSearch(ctx context.Context, keyword string, cursor Cursor) ([]Video, Cursor, error) {
 	body, err := s.browserAPIRequest(ctx, "/api/search/item/full/", func(p map[string]string) {
 		p["keyword"] = keyword
 		p["count"] = "20"
-		p["cursor"] = strconv.Itoa(cursor)
+		cursor.setParams(p)
 		p["from_page"] = "search"
 	})
 	if err != nil {
-		return nil, 0, fmt.Errorf("search request: %w", err)
+		return nil, Cursor{}, fmt.Errorf("search request: %w", err)
 	}
 
 	var result searchResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, 0, fmt.Errorf("decode search response (len %d): %w", len(body), err)
+		return nil, Cursor{}, fmt.Errorf("decode search response (len %d): %w", len(body), err)
+	}
+	if err := authStatusError(result.StatusCode); err != nil {
+		return nil, Cursor{}, err
+	}
+	if result.StatusCode == statusRegionRestricted {
+		return nil, Cursor{}, ErrRegionRestricted
 	}
 
 	videos := make([]Video, 0, len(result.ItemList))
@@ -99,19 +333,214 @@ func (s *Scraper) fetchSearch(ctx context.Context, keyword string, cursor int) (
 		videos = append(videos, parseVideo(raw))
 	}
 
-	nextCursor := 0
-	if result.HasMore == 1 {
+	var nextCursor Cursor
+	if result.HasMore {
 		nextCursor = result.Cursor
 	}
 	return videos, nextCursor, nil
 }
 
+// SearchMultipleKeywords runs SearchVideos for each keyword concurrently (up
+// to WithBatchConcurrency at a time) and returns up to limitEach videos per
+// keyword. A video found under several keywords is kept only under the first
+// of them in keywords order. If any search fails, the first failure in
+// keywords order is returned alongside the results of the others. Requests
+// are still spaced by the search delay. WithProgressFunc sees the combined
+// count across keywords against a total of limitEach per keyword.
+func (s *Scraper) SearchMultipleKeywords(ctx context.Context, keywords []string, limitEach int) (map[string][]Video, error) {
+	var unique []string
+	for _, kw := range keywords {
+		if !slices.Contains(unique, kw) {
+			unique = append(unique, kw)
+		}
+	}
+	keywords = unique
+	found, errs := s.searchKeywords(ctx, keywords, limitEach)
+
+	results := make(map[string][]Video, len(keywords))
+	seen := make(map[string]bool)
+	var firstErr error
+	for _, kw := range keywords {
+		if err := errs[kw]; err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("keyword %q: %w", kw, err)
+		}
+		videos := []Video{}
+		for _, v := range found[kw] {
+			if !seen[v.ID] {
+				seen[v.ID] = true
+				videos = append(videos, v)
+			}
+		}
+		results[kw] = videos
+	}
+	return results, firstErr
+}
+
+// searchKeywords runs the searches for SearchMultipleKeywords. Progress is
+// reported under mu as one running total, so the callback is never called
+// concurrently and its count never jumps between keywords.
+func (s *Scraper) searchKeywords(ctx context.Context, keywords []string, limitEach int) (map[string][]Video, map[string]error) {
+	found := make(map[string][]Video, len(keywords))
+	errs := make(map[string]error)
+	kept := make(map[string]int, len(keywords))
+	sum, total := 0, len(keywords)*limitEach
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.batchConcurrency)
+
+	for _, kw := range keywords {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			progress := func(n int) {
+				mu.Lock()
+				defer mu.Unlock()
+				n = min(n, limitEach)
+				sum, kept[kw] = sum+n-kept[kw], n
+				s.reportProgress(sum, total)
+			}
+			videos, err := s.runSearch(ctx, "tiktok.SearchVideos", kw, limitEach, nil, progress)
+			mu.Lock()
+			defer mu.Unlock()
+			found[kw] = videos
+			if err != nil {
+				errs[kw] = err
+			}
+		}()
+	}
+	wg.Wait()
+	return found, errs
+}
+
+// GetSearchSuggestions returns up to limit autocomplete suggestions for a
+// partial search query, in TikTok's order. Uses plain HTTP (no browser or
+// login needed).
+func (s *Scraper) GetSearchSuggestions(ctx context.Context, prefix string, limit int) ([]string, error) {
+	if prefix == "" {
+		return nil, fmt.Errorf("get search suggestions: prefix is required")
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	result, err := s.fetchSearchSuggestions(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("get search suggestions %q: %w", prefix, err)
+	}
+
+	suggestions := make([]string, 0, min(len(result.SugList), limit))
+	for _, sug := range result.SugList {
+		if sug.Content == "" {
+			continue
+		}
+		suggestions = append(suggestions, sug.Content)
+		if len(suggestions) == limit {
+			break
+		}
+	}
+	return suggestions, nil
+}
+
+// fetchSearchSuggestions fetches the autocomplete response for prefix.
+func (s *Scraper) fetchSearchSuggestions(ctx context.Context, prefix string) (searchSuggestResponse, error) {
+	params := s.buildAPIParams()
+	params.Set("keyword", prefix)
+	reqURL := s.baseURL + "/api/search/suggest/complete/?" + params.Encode()
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return searchSuggestResponse{}, err
+	}
+
+	resp, err := s.doRequest(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return searchSuggestResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return searchSuggestResponse{}, fmt.Errorf("read body: %w", err)
+	}
+
+	var result searchSuggestResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return searchSuggestResponse{}, fmt.Errorf("decode: %w", err)
+	}
+	return result, nil
+}
+
+// SearchUsers searches TikTok for accounts matching the keyword.
+// Requires an initialized browser (InitBrowser) and authentication.
+func (s *Scraper) SearchUsers(ctx context.Context, keyword string, limit int) ([]Author, error) {
+	if keyword == "" {
+		return nil, fmt.Errorf("search users: keyword is required")
+	}
+
+	var allUsers []Author
+	var cursor Cursor
+
+	for len(allUsers) < limit {
+		if err := s.waitForSearch(ctx); err != nil {
+			return allUsers, fmt.Errorf("search users %q: %w", keyword, err)
+		}
+
+		users, nextCursor, err := s.fetchUserSearch(ctx, keyword, cursor)
+		if err != nil {
+			return allUsers, fmt.Errorf("search users %q: %w", keyword, err)
+		}
+		allUsers = append(allUsers, users...)
+		if nextCursor.IsZero() {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(allUsers) > limit {
+		allUsers = allUsers[:limit]
+	}
+	return allUsers, nil
+}
+
+func (s *Scraper) fetchUserSearch(ctx context.Context, keyword string, cursor Cursor) ([]Author, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/search/user/full/", func(p map[string]string) {
+		p["keyword"] = keyword
+		p["count"] = "20"
+		cursor.setParams(p)
+		p["from_page"] = "search"
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("user search request: %w", err)
+	}
+
+	var result userSearchResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode user search response (len %d): %w", len(body), err)
+	}
+
+	users := make([]Author, 0, len(result.UserList))
+	for _, raw := range result.UserList {
+		users = append(users, parseAuthor(raw.UserInfo))
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = result.Cursor
+	}
+	return users, nextCursor, nil
+}
+
 // SearchByHashtag searches TikTok for videos under a specific hashtag.
 // Requires an initialized browser and authentication.
-func (s *Scraper) SearchByHashtag(ctx context.Context, hashtag string, limit int) ([]Video, error) {
+func (s *Scraper) SearchByHashtag(ctx context.Context, hashtag string, limit int) (_ []Video, err error) {
 	if hashtag == "" {
 		return nil, fmt.Errorf("search by hashtag: hashtag is required")
 	}
+	ctx, span := s.startSpan(ctx, "tiktok.SearchByHashtag")
+	span.setAttr("hashtag", hashtag)
+	defer func() { span.end(err) }()
 
 	challengeID, err := s.getChallengeID(ctx, hashtag)
 	if err != nil {
@@ -119,17 +548,20 @@ func (s *Scraper) SearchByHashtag(ctx context.Context, hashtag string, limit int
 	}
 
 	var allVideos []Video
-	cursor := 0
+	var cursor Cursor
 
 	for len(allVideos) < limit {
-		s.waitForSearch()
+		if err := s.waitForSearch(ctx); err != nil {
+			return allVideos, fmt.Errorf("fetch hashtag videos %q: %w", hashtag, err)
+		}
 
 		videos, nextCursor, err := s.fetchHashtagVideos(ctx, challengeID, cursor)
 		if err != nil {
 			return allVideos, fmt.Errorf("fetch hashtag videos %q: %w", hashtag, err)
 		}
 		allVideos = append(allVideos, videos...)
-		if nextCursor == 0 {
+		s.reportProgress(len(allVideos), limit)
+		if nextCursor.IsZero() {
 			break
 		}
 		cursor = nextCursor
@@ -141,38 +573,79 @@ func (s *Scraper) SearchByHashtag(ctx context.Context, hashtag string, limit int
 	return allVideos, nil
 }
 
+// GetVideosByRegionHashtag is SearchByHashtag with the API region param set
+// to region (an ISO 3166-1 alpha-2 code such as "BR") for this call only.
+// Returns ErrInvalidInput for an empty hashtag or region.
+func (s *Scraper) GetVideosByRegionHashtag(ctx context.Context, hashtag, region string, limit int) ([]Video, error) {
+	if hashtag == "" || region == "" {
+		return nil, fmt.Errorf("get videos by region hashtag: %w: hashtag and region are required", ErrInvalidInput)
+	}
+	ctx = withParamOverrides(ctx, map[string]string{"region": strings.ToUpper(region)})
+	return s.SearchByHashtag(ctx, hashtag, limit)
+}
+
+// paramOverrideKey is the context key for call-scoped API param overrides.
+type paramOverrideKey struct{}
+
+// withParamOverrides returns a context whose browserAPIRequest calls replace
+// the given query params, leaving the Scraper's own state untouched.
+func withParamOverrides(ctx context.Context, params map[string]string) context.Context {
+	return context.WithValue(ctx, paramOverrideKey{}, params)
+}
+
+func paramOverridesFromContext(ctx context.Context) map[string]string {
+	p, _ := ctx.Value(paramOverrideKey{}).(map[string]string)
+	return p
+}
+
 func (s *Scraper) getChallengeID(ctx context.Context, hashtag string) (string, error) {
+	info, err := s.fetchChallengeInfo(ctx, hashtag)
+	if err != nil {
+		return "", err
+	}
+	return info.Challenge.ID, nil
+}
+
+// fetchChallengeInfo fetches a hashtag's metadata and stats. Returns
+// ErrNotFound when TikTok has no challenge by that name.
+func (s *Scraper) fetchChallengeInfo(ctx context.Context, hashtag string) (rawChallengeInfo, error) {
 	body, err := s.browserAPIRequest(ctx, "/api/challenge/detail/", func(p map[string]string) {
 		p["challengeName"] = hashtag
 	})
 	if err != nil {
-		return "", fmt.Errorf("challenge detail: %w", err)
+		return rawChallengeInfo{}, fmt.Errorf("challenge detail: %w", err)
 	}
 
 	var result challengeDetailResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return "", fmt.Errorf("decode challenge detail: %w", err)
+		return rawChallengeInfo{}, fmt.Errorf("decode challenge detail: %w", err)
 	}
 
 	if result.ChallengeInfo.Challenge.ID == "" {
-		return "", fmt.Errorf("%w: challenge %q", ErrNotFound, hashtag)
+		return rawChallengeInfo{}, fmt.Errorf("%w: challenge %q", ErrNotFound, hashtag)
 	}
-	return result.ChallengeInfo.Challenge.ID, nil
+	return result.ChallengeInfo, nil
 }
 
-func (s *Scraper) fetchHashtagVideos(ctx context.Context, challengeID string, cursor int) ([]Video, int, error) {
+func (s *Scraper) fetchHashtagVideos(ctx context.Context, challengeID string, cursor Cursor) ([]Video, Cursor, error) {
 	body, err := s.browserAPIRequest(ctx, "/api/challenge/item_list/", func(p map[string]string) {
 		p["challengeID"] = challengeID
 		p["count"] = "35"
-		p["cursor"] = strconv.Itoa(cursor)
+		cursor.setParams(p)
 	})
 	if err != nil {
-		return nil, 0, fmt.Errorf("hashtag videos: %w", err)
+		return nil, Cursor{}, fmt.Errorf("hashtag videos: %w", err)
 	}
 
 	var result challengeItemListResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, 0, fmt.Errorf("decode hashtag videos: %w", err)
+		return nil, Cursor{}, fmt.Errorf("decode hashtag videos: %w", err)
+	}
+	if err := authStatusError(result.StatusCode); err != nil {
+		return nil, Cursor{}, err
+	}
+	if result.StatusCode == statusRegionRestricted {
+		return nil, Cursor{}, ErrRegionRestricted
 	}
 
 	videos := make([]Video, 0, len(result.ItemList))
@@ -180,7 +653,7 @@ func (s *Scraper) fetchHashtagVideos(ctx context.Context, challengeID string, cu
 		videos = append(videos, parseVideo(raw))
 	}
 
-	nextCursor := 0
+	var nextCursor Cursor
 	if result.HasMore {
 		nextCursor = result.Cursor
 	}
@@ -0,0 +1,77 @@
+package tiktok
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// socks4Granted is the SOCKS4 reply code for an accepted request.
+const socks4Granted = 0x5a
+
+// socks4Dialer dials through a SOCKS4 proxy. x/net/proxy only implements
+// SOCKS5, so SetProxy uses this for socks4:// URLs. Hostnames are sent with
+// the SOCKS4a extension and resolved by the proxy.
+type socks4Dialer struct {
+	proxyAddr string
+	userID    string
+	forward   *net.Dialer
+}
+
+// DialContext connects to addr through the proxy.
+func (d *socks4Dialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("socks4: %w", err)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("socks4: invalid port %q", portStr)
+	}
+
+	conn, err := d.forward.DialContext(ctx, "tcp", d.proxyAddr)
+	if err != nil {
+		return nil, fmt.Errorf("socks4: dial proxy: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	if err := socks4Connect(conn, host, uint16(port), d.userID); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// socks4Connect sends a CONNECT request for host:port on rw and checks the
+// proxy's reply.
+func socks4Connect(rw io.ReadWriter, host string, port uint16, userID string) error {
+	req := []byte{4, 1, byte(port >> 8), byte(port)}
+	ip := net.ParseIP(host).To4()
+	if ip == nil {
+		// SOCKS4a: an invalid 0.0.0.x address tells the proxy a hostname follows.
+		req = append(req, 0, 0, 0, 1)
+	} else {
+		req = append(req, ip...)
+	}
+	req = append(append(req, userID...), 0)
+	if ip == nil {
+		req = append(append(req, host...), 0)
+	}
+	if _, err := rw.Write(req); err != nil {
+		return fmt.Errorf("socks4: write request: %w", err)
+	}
+
+	var reply [8]byte
+	if _, err := io.ReadFull(rw, reply[:]); err != nil {
+		return fmt.Errorf("socks4: read reply: %w", err)
+	}
+	if reply[1] != socks4Granted {
+		return fmt.Errorf("socks4: connect to %s rejected (code %#x)", host, reply[1])
+	}
+	return nil
+}
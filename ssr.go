@@ -34,11 +34,22 @@ func extractUniversalData(htmlBody []byte) (universalData, error) {
 	return data, nil
 }
 
-// extractUserFromSSR pulls the Author from parsed SSR data.
+// extractUserFromSSR pulls the Author from parsed SSR data. Private profiles
+// still carry full user data and parse with Author.Private set. Only when the
+// user object is missing does it fail: with ErrBannedAccount for a suspended
+// account, ErrPrivateAccount if the payload still flags the profile private,
+// and ErrNotFound otherwise.
 func extractUserFromSSR(data universalData) (Author, error) {
-	info := data.DefaultScope.UserDetail.UserInfo
-	if info.User.UniqueID == "" {
-		return Author{}, fmt.Errorf("%w: user data missing in ssr response", ErrNotFound)
+	detail := data.DefaultScope.UserDetail
+	info := detail.UserInfo
+	if info.User.UniqueID != "" {
+		return parseAuthor(info), nil
 	}
-	return parseAuthor(info), nil
+	switch {
+	case detail.StatusCode == statusBannedAccount:
+		return Author{}, fmt.Errorf("%w: account is suspended", ErrBannedAccount)
+	case info.User.PrivateAccount:
+		return Author{}, fmt.Errorf("%w: profile is private", ErrPrivateAccount)
+	}
+	return Author{}, fmt.Errorf("%w: user data missing in ssr response", ErrNotFound)
 }
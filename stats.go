@@ -0,0 +1,60 @@
+package tiktok
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// ScraperStats is a snapshot of a Scraper's activity since it was created or
+// ResetStats was called.
+type ScraperStats struct {
+	RequestCount    int64 // HTTP requests sent plus browser fetches, including retries.
+	BytesReceived   int64 // Response body bytes read from those requests.
+	ErrorCount      int64 // HTTP requests and browser fetches that failed.
+	CacheHits       int64 // Responses served from WithCache; zero when disabled.
+	BrowserSignings int64 // frontierSign calls made in the browser.
+}
+
+// Stats returns a snapshot of the Scraper's counters. It is safe to call
+// concurrently with requests.
+func (s *Scraper) Stats() ScraperStats {
+	hits, _ := s.cache.stats()
+	return ScraperStats{
+		RequestCount:    s.requestCount.Load(),
+		BytesReceived:   s.bytesReceived.Load(),
+		ErrorCount:      s.errorCount.Load(),
+		CacheHits:       int64(hits),
+		BrowserSignings: s.browserSignings.Load(),
+	}
+}
+
+// ResetStats zeroes the counters behind Stats, including the cache hit and
+// miss counts reported by CacheStats.
+func (s *Scraper) ResetStats() {
+	s.requestCount.Store(0)
+	s.bytesReceived.Store(0)
+	s.errorCount.Store(0)
+	s.browserSignings.Store(0)
+	s.cache.resetStats()
+}
+
+// recordBrowserFetch counts a completed browser fetch.
+func (s *Scraper) recordBrowserFetch(body []byte, err error) {
+	s.requestCount.Add(1)
+	s.bytesReceived.Add(int64(len(body)))
+	if err != nil {
+		s.errorCount.Add(1)
+	}
+}
+
+// countingBody adds the bytes read from a response body to n.
+type countingBody struct {
+	io.ReadCloser
+	n *atomic.Int64
+}
+
+func (b countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n.Add(int64(n))
+	return n, err
+}
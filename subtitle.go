@@ -0,0 +1,79 @@
+package tiktok
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseSubtitleCues parses a WebVTT or SRT file into cues. Blocks without a
+// timing line (the WEBVTT header, NOTE and STYLE blocks) are skipped.
+func parseSubtitleCues(data []byte) ([]SubtitleCue, error) {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	var cues []SubtitleCue
+	for block := range strings.SplitSeq(string(data), "\n\n") {
+		lines := strings.Split(strings.Trim(block, "\n"), "\n")
+		// The timing line is first, or second after a cue identifier.
+		i := slices.IndexFunc(lines, func(l string) bool { return strings.Contains(l, "-->") })
+		if i < 0 || i > 1 {
+			continue
+		}
+		start, end, err := parseCueTiming(lines[i])
+		if err != nil {
+			return nil, err
+		}
+		cues = append(cues, SubtitleCue{
+			Start: start,
+			End:   end,
+			Text:  strings.Join(lines[i+1:], "\n"),
+		})
+	}
+	return cues, nil
+}
+
+// parseCueTiming parses "00:00:01.000 --> 00:00:02.500", ignoring any WebVTT
+// cue settings after the end time.
+func parseCueTiming(line string) (start, end time.Duration, err error) {
+	from, to, _ := strings.Cut(line, "-->")
+	if start, err = parseCueTimestamp(strings.TrimSpace(from)); err != nil {
+		return 0, 0, err
+	}
+	fields := strings.Fields(to)
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("%w: subtitle timing %q", ErrInvalidResponse, line)
+	}
+	if end, err = parseCueTimestamp(fields[0]); err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+// parseCueTimestamp parses [hh:]mm:ss.mmm, with "," as the SRT decimal
+// separator.
+func parseCueTimestamp(ts string) (time.Duration, error) {
+	invalid := fmt.Errorf("%w: subtitle timestamp %q", ErrInvalidResponse, ts)
+	clock, frac, ok := strings.Cut(strings.Replace(ts, ",", ".", 1), ".")
+	if !ok {
+		return 0, invalid
+	}
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, invalid
+	}
+	var d time.Duration
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return 0, invalid
+		}
+		d = d*60 + time.Duration(n)
+	}
+	ms, err := strconv.Atoi(frac)
+	if err != nil || len(frac) != 3 {
+		return 0, invalid
+	}
+	return d*time.Second + time.Duration(ms)*time.Millisecond, nil
+}
@@ -0,0 +1,46 @@
+package tiktok
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// tracer starts trace spans. The OpenTelemetry implementation lives in
+// tracing_otel.go behind the "otel" build tag (see WithTracerProvider), so
+// the default build has no OpenTelemetry dependency and tracing is a no-op.
+type tracer interface {
+	start(ctx context.Context, name string) (context.Context, traceSpan)
+}
+
+// traceSpan is an in-flight span.
+type traceSpan interface {
+	setAttr(key string, value any)
+	end(err error) // Records err, if non-nil, as the span's error status.
+}
+
+type noopSpan struct{}
+
+func (noopSpan) setAttr(string, any) {}
+func (noopSpan) end(error)           {}
+
+// startSpan starts a span named name, or a no-op span when no tracer is set.
+func (s *Scraper) startSpan(ctx context.Context, name string) (context.Context, traceSpan) {
+	if s.tracer == nil {
+		return ctx, noopSpan{}
+	}
+	return s.tracer.start(ctx, name)
+}
+
+// spanURL returns rawURL with volatileParams removed so the msToken session
+// cookie and request signatures never reach a trace backend. Unparsable URLs
+// are recorded without their query.
+func spanURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		base, _, _ := strings.Cut(rawURL, "?")
+		return base
+	}
+	u.RawQuery = stableQuery(u.Query())
+	return u.String()
+}
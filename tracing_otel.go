@@ -0,0 +1,67 @@
+//go:build otel
+
+package tiktok
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies this library as the instrumentation scope.
+const tracerName = "github.com/RavensCloud/tiktok-gofun"
+
+// WithTracerProvider records OpenTelemetry spans for GetUser, SearchVideos,
+// SearchByHashtag, browserAPIRequest, and doRequest, named
+// "tiktok.<operation>", with the request URL, status code, response size,
+// and pagination cursor as attributes. A nil tp disables tracing.
+//
+// Only available when built with -tags otel; the calling module must
+// require go.opentelemetry.io/otel.
+func (s *Scraper) WithTracerProvider(tp trace.TracerProvider) *Scraper {
+	if tp == nil {
+		s.tracer = nil
+		return s
+	}
+	s.tracer = otelTracer{tp.Tracer(tracerName)}
+	return s
+}
+
+type otelTracer struct {
+	t trace.Tracer
+}
+
+func (o otelTracer) start(ctx context.Context, name string) (context.Context, traceSpan) {
+	ctx, span := o.t.Start(ctx, name)
+	return ctx, otelSpan{span}
+}
+
+type otelSpan struct {
+	span trace.Span
+}
+
+func (o otelSpan) setAttr(key string, value any) {
+	var kv attribute.KeyValue
+	switch v := value.(type) {
+	case string:
+		kv = attribute.String(key, v)
+	case int:
+		kv = attribute.Int(key, v)
+	case bool:
+		kv = attribute.Bool(key, v)
+	default:
+		kv = attribute.String(key, fmt.Sprint(v))
+	}
+	o.span.SetAttributes(kv)
+}
+
+func (o otelSpan) end(err error) {
+	if err != nil {
+		o.span.RecordError(err)
+		o.span.SetStatus(codes.Error, err.Error())
+	}
+	o.span.End()
+}
@@ -0,0 +1,66 @@
+package tiktok
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+)
+
+// TrendWeights tunes TrendScore: per-interaction weights and the daily
+// exponential decay rate applied to a video's age.
+type TrendWeights struct {
+	Likes, Comments, Shares float64
+	DecayRate               float64
+}
+
+// DefaultTrendWeights weights effortful interactions higher (share > comment
+// > like) and decays the score by e^(-0.1) per day of age.
+var DefaultTrendWeights = TrendWeights{Likes: 1, Comments: 2, Shares: 3, DecayRate: 0.1}
+
+// TrendScore is a virality score for v using DefaultTrendWeights: weighted
+// interactions per view, discounted by daysOld.
+func TrendScore(v Video, daysOld int) float64 {
+	return DefaultTrendWeights.Score(v, daysOld)
+}
+
+// Score computes the trend score of v with these weights.
+func (w TrendWeights) Score(v Video, daysOld int) float64 {
+	interactions := float64(v.Likes)*w.Likes + float64(v.Comments)*w.Comments + float64(v.Shares)*w.Shares
+	perView := interactions / float64(max(v.Views, 1))
+	return perView * math.Exp(-w.DecayRate*float64(daysOld))
+}
+
+// SortBy selects the ordering used by SortVideos.
+type SortBy int
+
+const (
+	// SortByTrendScore orders by TrendScore, highest first. A video's age is
+	// taken from CreatedAt.
+	SortByTrendScore SortBy = iota + 1
+)
+
+// SortVideos sorts videos in place by the given metric, using
+// DefaultTrendWeights for SortByTrendScore.
+func SortVideos(videos []Video, by SortBy) {
+	sortVideos(videos, by, DefaultTrendWeights)
+}
+
+// SortVideos sorts videos in place by the given metric, using the weights set
+// with WithTrendWeights for SortByTrendScore.
+func (s *Scraper) SortVideos(videos []Video, by SortBy) {
+	sortVideos(videos, by, s.trendWeights)
+}
+
+func sortVideos(videos []Video, by SortBy, w TrendWeights) {
+	if by != SortByTrendScore {
+		return
+	}
+	now := time.Now()
+	score := func(v Video) float64 {
+		return w.Score(v, int(now.Sub(v.CreatedAt).Hours()/24))
+	}
+	slices.SortStableFunc(videos, func(a, b Video) int {
+		return cmp.Compare(score(b), score(a))
+	})
+}
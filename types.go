@@ -1,31 +1,202 @@
 package tiktok
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
-// Video represents a TikTok video with its engagement metrics.
+// Video represents a TikTok video with its engagement metrics. It marshals to
+// JSON with snake_case keys and CreatedAt as a Unix timestamp in seconds.
 type Video struct {
-	ID          string
-	Description string
-	AuthorID    string
-	Username    string
-	CreatedAt   time.Time
-	Views       int
-	Likes       int
-	Comments    int
-	Shares      int
+	ID             string    `json:"id"`
+	Description    string    `json:"description"`
+	AuthorID       string    `json:"author_id"`
+	Username       string    `json:"username"`
+	AuthorVerified bool      `json:"author_verified"` // Author has the blue checkmark.
+	CreatedAt      time.Time `json:"created_at"`
+	Views          int64     `json:"views"`
+	Likes          int64     `json:"likes"`
+	Comments       int64     `json:"comments"`
+	Shares         int64     `json:"shares"`
+	Duration       int       `json:"duration"` // Seconds.
+
+	// Media links. VideoURL is the playback address, which TikTok signs and
+	// expires after a few hours; ShareURL is the stable public page.
+	ThumbnailURL string `json:"thumbnail_url"`
+	ShareURL     string `json:"share_url"`
+	VideoURL     string `json:"video_url"`
+
+	// VideoQualities lists the available encodings (e.g. 540p, 720p, 1080p).
+	VideoQualities []VideoQuality `json:"video_qualities"`
+
+	// Hashtags (without '#') and mentioned user IDs tagged in Description,
+	// in order of appearance. Description itself is left unchanged.
+	Hashtags []string `json:"hashtags"`
+	Mentions []string `json:"mentions"`
+
+	// Stickers overlaid on the video; branded stickers carry a stable ID.
+	StickerIDs   []string `json:"sticker_ids"`
+	StickerTexts []string `json:"sticker_texts"`
+
+	// EffectID is the first effect (filter or AR effect) used, if any. See
+	// GetVideosByEffect.
+	EffectID string `json:"effect_id"`
+
+	// NextVideoID is TikTok's suggested next video, if provided.
+	NextVideoID string `json:"next_video_id"`
+
+	// Sound used by the video.
+	MusicID    string `json:"music_id"`
+	MusicTitle string `json:"music_title"`
+
+	// AI-generated content label set by TikTok.
+	IsAIGenerated   bool   `json:"is_ai_generated"`
+	AIGCDescription string `json:"aigc_description"`
+}
+
+// MarshalJSON encodes CreatedAt as Unix seconds (0 for the zero time).
+func (v Video) MarshalJSON() ([]byte, error) {
+	type alias Video
+	return json.Marshal(struct {
+		alias
+		CreatedAt int64 `json:"created_at"`
+	}{alias(v), unixSeconds(v.CreatedAt)})
+}
+
+// UnmarshalJSON decodes CreatedAt from Unix seconds.
+func (v *Video) UnmarshalJSON(data []byte) error {
+	type alias Video
+	aux := struct {
+		*alias
+		CreatedAt int64 `json:"created_at"`
+	}{alias: (*alias)(v)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	v.CreatedAt = time.Time{}
+	if aux.CreatedAt != 0 {
+		v.CreatedAt = time.Unix(aux.CreatedAt, 0)
+	}
+	return nil
+}
+
+func unixSeconds(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// VideoQuality describes one available encoding of a video.
+type VideoQuality struct {
+	GearName   string  `json:"gear_name"` // TikTok's quality label, e.g. "normal_720_0".
+	PlayURL    string  `json:"play_url"`
+	Bitrate    int     `json:"bitrate"` // Bits per second.
+	FileSizeMB float64 `json:"file_size_mb"`
+}
+
+// Subtitle is one subtitle track of a video, usually auto-generated by
+// TikTok's speech recognition.
+type Subtitle struct {
+	Language   string // Language code, e.g. "eng-US".
+	LanguageID string
+	URL        string
+	Format     string // e.g. "webvtt"
+	Cues       []SubtitleCue
+}
+
+// SubtitleCue is one timed line of a subtitle track.
+type SubtitleCue struct {
+	Start, End time.Duration
+	Text       string
 }
 
 // Author represents a TikTok user profile with their stats.
 type Author struct {
+	ID             string `json:"id"`
+	Username       string `json:"username"`
+	SecUID         string `json:"sec_uid"`  // Stable ID required by list endpoints (posts, likes, followers).
+	Nickname       string `json:"nickname"` // Display name (bot detection: random/empty patterns).
+	FollowerCount  int    `json:"follower_count"`
+	FollowingCount int    `json:"following_count"`
+	VideoCount     int    `json:"video_count"` // From SSR, which TikTok's CDN may cache; see GetUserVideosCount.
+	HeartCount     int    `json:"heart_count"` // Total likes received across all videos.
+	DiggCount      int    `json:"digg_count"`  // Total likes given by the user.
+	Verified       bool   `json:"verified"`
+	Private        bool   `json:"private"` // Videos and lists need an approved follow; profile data is still public.
+	Bio            string `json:"bio"`
+	AvatarURL      string `json:"avatar_url"`
+
+	// PinnedVideoIDs lists the videos pinned to the top of the profile (at
+	// most three). See GetUserPinnedVideos.
+	PinnedVideoIDs []string `json:"pinned_video_ids"`
+}
+
+// Hashtag represents a TikTok hashtag (called a "challenge" in the API).
+type Hashtag struct {
+	ID          string
+	Title       string // Hashtag name without the leading '#'.
+	Description string
+	VideoCount  int
+	ViewCount   int
+}
+
+// Sound represents a TikTok sound (music track or original audio).
+type Sound struct {
+	ID         string
+	Title      string
+	AuthorName string
+	Duration   int // Seconds.
+	CoverURL   string
+	VideoCount int // Number of videos using the sound.
+}
+
+// Effect represents a TikTok effect (filter or AR effect).
+type Effect struct {
+	ID          string
+	Name        string
+	Description string
+	VideoCount  int // Number of videos using the effect.
+	CoverURL    string
+}
+
+// LiveStream is a live broadcast currently on air.
+type LiveStream struct {
+	ID           string // Room ID.
+	Title        string
+	Username     string // Host's username.
+	AuthorID     string
+	ViewerCount  int // Current viewers.
+	StartedAt    time.Time
+	ThumbnailURL string
+	ShareURL     string
+}
+
+// AudienceStats is the audience breakdown of a video, available to its
+// creator via TikTok's Creator tools. Breakdown values are shares in [0, 1].
+type AudienceStats struct {
+	GenderBreakdown  map[string]float64 // e.g. "female" -> 0.62
+	AgeBreakdown     map[string]float64 // e.g. "18-24" -> 0.41
+	TopCountries     []string           // Country codes, largest audience first.
+	AverageWatchTime time.Duration
+}
+
+// Comment is a top-level comment on a video.
+type Comment struct {
 	ID             string
-	Username       string
-	Nickname       string // Display name (bot detection: random/empty patterns).
-	FollowerCount  int
-	FollowingCount int
-	VideoCount     int
-	HeartCount     int // Total likes received across all videos.
-	DiggCount      int // Total likes given by the user.
-	Verified       bool
-	Bio            string
-	AvatarURL      string
+	Text           string
+	AuthorID       string
+	AuthorUsername string
+	Likes          int
+	ReplyCount     int
+	CreatedAt      time.Time
+	IsAuthorLiked  bool // The video's creator liked this comment.
+}
+
+// UserComment is a comment a user left on someone's video.
+type UserComment struct {
+	Text        string
+	VideoID     string
+	VideoAuthor string // Username of the video's author.
+	CreatedAt   time.Time
 }
@@ -1,6 +1,12 @@
 package tiktok
 
-import "time"
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 // Search API response — flat structure returned by TikTok's API when
 // fetched via the browser. Fields are at the top level, not wrapped in a
@@ -9,8 +15,107 @@ import "time"
 type searchResponse struct {
 	StatusCode int        `json:"status_code"`
 	ItemList   []rawVideo `json:"item_list"`
-	HasMore    int        `json:"has_more"` // 0 or 1, not bool.
-	Cursor     int        `json:"cursor"`
+	HasMore    flexBool   `json:"has_more"` // Usually 0 or 1, sometimes a bool.
+	Cursor     Cursor     `json:"cursor"`
+}
+
+// flexBool decodes a flag TikTok sends either as a JSON bool or as a number
+// (0 or 1) depending on the endpoint and API version. Any nonzero number is
+// true; null is false.
+type flexBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("true")):
+		*b = true
+	case bytes.Equal(data, []byte("false")), bytes.Equal(data, []byte("null")):
+		*b = false
+	default:
+		var n float64
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("decode flag %s: want bool or number: %w", data, err)
+		}
+		*b = n != 0
+	}
+	return nil
+}
+
+// For You feed API response (/api/feed/). Same item_list as search, but
+// paged with a min/max cursor pair.
+
+type feedResponse struct {
+	StatusCode int        `json:"status_code"`
+	ItemList   []rawVideo `json:"item_list"`
+	HasMore    flexBool   `json:"has_more"`
+	MinCursor  int        `json:"min_cursor"`
+	MaxCursor  int        `json:"max_cursor"`
+}
+
+// Discovery feed API response (/api/recommend/item_list/), camelCase like
+// the post list.
+
+type recommendItemListResponse struct {
+	StatusCode int        `json:"statusCode"`
+	ItemList   []rawVideo `json:"itemList"`
+	HasMore    flexBool   `json:"hasMore"`
+	Cursor     Cursor     `json:"cursor"`
+}
+
+type userSearchResponse struct {
+	StatusCode int               `json:"status_code"`
+	UserList   []rawSearchedUser `json:"user_list"`
+	HasMore    flexBool          `json:"has_more"`
+	Cursor     Cursor            `json:"cursor"`
+}
+
+type rawSearchedUser struct {
+	UserInfo rawUserInfo `json:"userInfo"`
+}
+
+// Search autocomplete response (/api/search/suggest/complete/).
+
+type searchSuggestResponse struct {
+	StatusCode int                `json:"status_code"`
+	SugList    []rawSearchSuggest `json:"sug_list"`
+}
+
+type rawSearchSuggest struct {
+	Content string `json:"content"`
+}
+
+// User detail API response (/api/user/detail/). Unlike the other endpoints
+// it uses a camelCase statusCode.
+
+type rawUserDetailResponse struct {
+	StatusCode int         `json:"statusCode"`
+	UserInfo   rawUserInfo `json:"userInfo"`
+}
+
+// QR login API responses (/api/qrcode/generate/, /api/qrcode/check/).
+
+type rawQRGenerateResponse struct {
+	StatusCode int `json:"status_code"`
+	Data       struct {
+		Token     string `json:"token"`
+		QRCodeURL string `json:"qrcode_index_url"`
+	} `json:"data"`
+}
+
+type rawQRCheckResponse struct {
+	StatusCode int `json:"status_code"`
+	Data       struct {
+		Status string `json:"status"` // new, scanned, confirmed, qrcode_expired
+	} `json:"data"`
+}
+
+// Account suggestion API response (/api/user/suggest/). Entries share the
+// user search shape.
+
+type rawSuggestResponse struct {
+	StatusCode int               `json:"status_code"`
+	UserList   []rawSearchedUser `json:"user_list"`
 }
 
 // Challenge/hashtag API responses.
@@ -35,20 +140,290 @@ type rawChallengeStats struct {
 	ViewCount  int `json:"viewCount"`
 }
 
+// challengeSearchResponse is returned by /api/challenge/search/ and
+// /api/recommend/item_list/challenge/. Unlike the challenge detail endpoint
+// it uses snake_case keys.
+type challengeSearchResponse struct {
+	StatusCode    int                    `json:"status_code"`
+	ChallengeList []rawSearchedChallenge `json:"challenge_list"`
+}
+
+type rawSearchedChallenge struct {
+	ChallengeInfo rawSearchedChallengeInfo `json:"challenge_info"`
+}
+
+type rawSearchedChallengeInfo struct {
+	CID       string `json:"cid"`
+	ChaName   string `json:"cha_name"`
+	Desc      string `json:"desc"`
+	UserCount int    `json:"user_count"` // Number of videos using the hashtag.
+	ViewCount int    `json:"view_count"`
+}
+
 type challengeItemListResponse struct {
+	StatusCode int        `json:"status_code"`
+	ItemList   []rawVideo `json:"itemList"`
+	HasMore    flexBool   `json:"hasMore"`
+	Cursor     Cursor     `json:"cursor"`
+}
+
+// Item detail API response. Deleted or private videos return an empty itemStruct.
+
+type itemDetailResponse struct {
+	StatusCode int         `json:"statusCode"`
+	ItemInfo   rawItemInfo `json:"itemInfo"`
+}
+
+type rawItemInfo struct {
+	ItemStruct rawVideo `json:"itemStruct"`
+}
+
+// Creator tools audience stats API response.
+
+type rawAudienceStatsResponse struct {
+	StatusCode int              `json:"status_code"`
+	StatusMsg  string           `json:"status_msg"`
+	Data       rawAudienceStats `json:"data"`
+}
+
+type rawAudienceStats struct {
+	GenderDistribution  []rawDistributionItem `json:"gender_distribution"`
+	AgeDistribution     []rawDistributionItem `json:"age_distribution"`
+	CountryDistribution []rawDistributionItem `json:"country_distribution"` // Sorted by share, descending.
+	AvgWatchTime        float64               `json:"avg_watch_time"`       // Seconds.
+}
+
+type rawDistributionItem struct {
+	Key   string  `json:"key"`
+	Value float64 `json:"value"` // Share in [0, 1].
+}
+
+// User post list API response (same shape as the challenge item list).
+
+type postItemListResponse struct {
+	ItemList []rawVideo `json:"itemList"`
+	HasMore  flexBool   `json:"hasMore"`
+	Cursor   Cursor     `json:"cursor"`
+}
+
+// Liked videos API response (/api/favorite/item_list/). Private likes come
+// back as an empty list with status_code 10318.
+
+type favoriteItemListResponse struct {
+	StatusCode int        `json:"status_code"`
+	ItemList   []rawVideo `json:"itemList"`
+	HasMore    flexBool   `json:"hasMore"`
+	Cursor     Cursor     `json:"cursor"`
+}
+
+// Follower/following list API response (/api/user/list/). Pages with
+// minCursor; maxCursor stays 0.
+
+type userListResponse struct {
+	StatusCode int           `json:"statusCode"`
+	UserList   []rawUserInfo `json:"userList"`
+	HasMore    flexBool      `json:"hasMore"`
+	MinCursor  int           `json:"minCursor"`
+}
+
+// Music/sound API responses.
+
+type musicDetailResponse struct {
+	MusicInfo rawMusicInfo `json:"musicInfo"`
+}
+
+type rawMusicInfo struct {
+	Music rawMusic      `json:"music"`
+	Stats rawMusicStats `json:"stats"`
+}
+
+type rawMusicStats struct {
+	VideoCount int `json:"videoCount"`
+}
+
+type musicItemListResponse struct {
 	ItemList []rawVideo `json:"itemList"`
-	HasMore  bool       `json:"hasMore"`
-	Cursor   int        `json:"cursor"`
+	HasMore  flexBool   `json:"hasMore"`
+	Cursor   Cursor     `json:"cursor"`
+}
+
+// Effect detail and item list API responses (/api/effect/detail/,
+// /api/effect/item_list/), shaped like the music ones.
+
+type effectDetailResponse struct {
+	EffectInfo rawEffectInfo `json:"effectInfo"`
+}
+
+type rawEffectInfo struct {
+	Effect rawEffect      `json:"effect"`
+	Stats  rawEffectStats `json:"stats"`
+}
+
+type rawEffectStats struct {
+	VideoCount int `json:"videoCount"`
+}
+
+type effectItemListResponse struct {
+	ItemList []rawVideo `json:"itemList"`
+	HasMore  flexBool   `json:"hasMore"`
+	Cursor   Cursor     `json:"cursor"`
+}
+
+// Live room list API response (/api/recommend/search/live/), snake_case like
+// search. IDs are sent as strings to avoid float precision loss in JS.
+
+type liveListResponse struct {
+	StatusCode int           `json:"status_code"`
+	RoomList   []rawLiveRoom `json:"room_list"`
+	HasMore    flexBool      `json:"has_more"`
+	Cursor     Cursor        `json:"cursor"`
+}
+
+type rawLiveRoom struct {
+	ID         string       `json:"id_str"`
+	Title      string       `json:"title"`
+	UserCount  int          `json:"user_count"` // Current viewers.
+	CreateTime int64        `json:"create_time"`
+	ShareURL   string       `json:"share_url"`
+	Owner      rawLiveOwner `json:"owner"`
+	Cover      rawLiveCover `json:"cover"`
+}
+
+type rawLiveOwner struct {
+	ID        string `json:"id_str"`
+	DisplayID string `json:"display_id"` // Username.
+}
+
+type rawLiveCover struct {
+	URLList []string `json:"url_list"`
+}
+
+// Comment API responses (snake_case, like search).
+
+type commentListResponse struct {
+	StatusCode int          `json:"status_code"`
+	Comments   []rawComment `json:"comments"`
+	HasMore    flexBool     `json:"has_more"`
+	Cursor     Cursor       `json:"cursor"`
+}
+
+type rawComment struct {
+	CID               string         `json:"cid"`
+	Text              string         `json:"text"`
+	CreateTime        int64          `json:"create_time"`
+	DiggCount         int            `json:"digg_count"`
+	ReplyCommentTotal int            `json:"reply_comment_total"`
+	IsAuthorDigged    bool           `json:"is_author_digged"` // Liked by the video's author.
+	User              rawCommentUser `json:"user"`
+}
+
+type rawUserCommentResponse struct {
+	StatusCode int              `json:"status_code"`
+	Comments   []rawUserComment `json:"comments"`
+	HasMore    flexBool         `json:"has_more"`
+	Cursor     Cursor           `json:"cursor"`
+}
+
+type rawUserComment struct {
+	CID         string         `json:"cid"`
+	Text        string         `json:"text"`
+	AwemeID     string         `json:"aweme_id"` // ID of the video commented on.
+	CreateTime  int64          `json:"create_time"`
+	AwemeAuthor rawCommentUser `json:"aweme_author"`
+}
+
+type rawCommentUser struct {
+	UID      string `json:"uid"`
+	UniqueID string `json:"unique_id"`
 }
 
 // Shared raw video/author/stats structs (match TikTok JSON exactly).
 
 type rawVideo struct {
-	ID         string    `json:"id"`
-	Desc       string    `json:"desc"`
-	CreateTime int64     `json:"createTime"`
-	Author     rawAuthor `json:"author"`
-	Stats      rawStats  `json:"stats"`
+	ID         string       `json:"id"`
+	Desc       string       `json:"desc"`
+	CreateTime int64        `json:"createTime"`
+	Author     rawAuthor    `json:"author"`
+	Stats      rawStats     `json:"stats"`
+	Video      rawVideoMeta `json:"video"`
+
+	Music          rawMusic     `json:"music"`
+	StickersOnItem []rawSticker `json:"stickersOnItem"`
+	EffectStickers []rawEffect  `json:"effectStickers"`
+	TextExtra      rawTextExtra `json:"textExtra"`
+
+	// SuggestedNextVideoID is the video TikTok pre-fetches to play next.
+	SuggestedNextVideoID string `json:"suggestedVideoId"`
+
+	// AI-generated content label.
+	IsAIGC          bool   `json:"isAigc"`
+	AIGCDescription string `json:"aigcDescription"`
+}
+
+// rawMusic is the sound attached to a video, also used by the music detail API.
+type rawMusic struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	AuthorName string `json:"authorName"`
+	Duration   int    `json:"duration"` // Seconds.
+	CoverLarge string `json:"coverLarge"`
+}
+
+// rawSticker is a text or branded sticker overlaid on a video.
+type rawSticker struct {
+	StickerID   string   `json:"stickerID"`
+	StickerText []string `json:"stickerText"`
+}
+
+// rawEffect is an effect applied to a video, also used by the effect detail
+// API. Note the uppercase "ID" key.
+type rawEffect struct {
+	ID       string `json:"ID"`
+	Name     string `json:"name"`
+	Desc     string `json:"desc"`
+	CoverURL string `json:"coverUrl"`
+}
+
+// rawTextExtra annotates the description's #hashtags and @mentions. Each item
+// carries either a hashtagName or a userId.
+type rawTextExtra []rawTextExtraItem
+
+type rawTextExtraItem struct {
+	HashtagName string `json:"hashtagName"`
+	UserID      string `json:"userId"`
+}
+
+// rawVideoMeta is the nested "video" object describing the media file.
+type rawVideoMeta struct {
+	Duration     int              `json:"duration"` // Seconds.
+	Cover        string           `json:"cover"`
+	PlayAddr     string           `json:"playAddr"`
+	DownloadAddr string           `json:"downloadAddr"` // Watermarked.
+	ShareURL     string           `json:"shareUrl"`
+	BitrateInfo  []rawBitrateInfo `json:"bitrateInfo"`
+	Subtitles    []rawSubtitle    `json:"subtitleInfos"`
+}
+
+// rawSubtitle is one entry of subtitleInfos, again with PascalCase keys.
+// LanguageID is a number, sometimes sent as a string.
+type rawSubtitle struct {
+	LanguageCodeName string      `json:"LanguageCodeName"`
+	LanguageID       json.Number `json:"LanguageID"`
+	URL              string      `json:"Url"`
+	Format           string      `json:"Format"`
+}
+
+// rawBitrateInfo is one available encoding of a video. TikTok uses PascalCase
+// keys here and nests the play URL and size under PlayAddr.
+type rawBitrateInfo struct {
+	GearName string      `json:"GearName"`
+	Bitrate  int         `json:"Bitrate"`
+	PlayAddr rawPlayAddr `json:"PlayAddr"`
+}
+
+type rawPlayAddr struct {
+	DataSize int64    `json:"DataSize"`
+	URLList  []string `json:"UrlList"`
 }
 
 type rawAuthor struct {
@@ -59,11 +434,13 @@ type rawAuthor struct {
 	Verified    bool   `json:"verified"`
 }
 
+// rawStats uses int64 explicitly: top videos exceed 450M plays, which would
+// overflow a 32-bit int.
 type rawStats struct {
-	PlayCount    int `json:"playCount"`
-	DiggCount    int `json:"diggCount"`
-	ShareCount   int `json:"shareCount"`
-	CommentCount int `json:"commentCount"`
+	PlayCount    int64 `json:"playCount"`
+	DiggCount    int64 `json:"diggCount"`
+	ShareCount   int64 `json:"shareCount"`
+	CommentCount int64 `json:"commentCount"`
 }
 
 // SSR (Server-Side Rendered) data structs for __UNIVERSAL_DATA_FOR_REHYDRATION__.
@@ -77,7 +454,8 @@ type defaultScope struct {
 }
 
 type userDetailWrapper struct {
-	UserInfo rawUserInfo `json:"userInfo"`
+	StatusCode int         `json:"statusCode"`
+	UserInfo   rawUserInfo `json:"userInfo"`
 }
 
 type rawUserInfo struct {
@@ -93,6 +471,11 @@ type rawUserDetail struct {
 	Signature    string `json:"signature"`
 	Verified     bool   `json:"verified"`
 	SecUID       string `json:"secUid"`
+
+	PinVideoIDs []string `json:"pinVideoIds"` // Up to three, in profile order.
+
+	PrivateAccount bool `json:"privateAccount"`
+	Secret         bool `json:"secret"` // Also set on private accounts.
 }
 
 type rawUserStats struct {
@@ -106,17 +489,75 @@ type rawUserStats struct {
 
 // parseVideo converts a raw TikTok API video to the public Video type.
 func parseVideo(raw rawVideo) Video {
-	return Video{
-		ID:          raw.ID,
-		Description: raw.Desc,
-		AuthorID:    raw.Author.ID,
-		Username:    raw.Author.UniqueID,
-		CreatedAt:   time.Unix(raw.CreateTime, 0),
-		Views:       raw.Stats.PlayCount,
-		Likes:       raw.Stats.DiggCount,
-		Comments:    raw.Stats.CommentCount,
-		Shares:      raw.Stats.ShareCount,
+	v := Video{
+		ID:             raw.ID,
+		Description:    raw.Desc,
+		AuthorID:       raw.Author.ID,
+		Username:       raw.Author.UniqueID,
+		AuthorVerified: raw.Author.Verified,
+		CreatedAt:      time.Unix(raw.CreateTime, 0),
+		Views:          raw.Stats.PlayCount,
+		Likes:          raw.Stats.DiggCount,
+		Comments:       raw.Stats.CommentCount,
+		Shares:         raw.Stats.ShareCount,
+		Duration:       raw.Video.Duration,
+		ThumbnailURL:   raw.Video.Cover,
+		ShareURL:       raw.Video.ShareURL,
+		VideoURL:       cmp.Or(raw.Video.PlayAddr, raw.Video.DownloadAddr),
+
+		VideoQualities: parseVideoQualities(raw.Video.BitrateInfo),
+		NextVideoID:    raw.SuggestedNextVideoID,
+		MusicID:        raw.Music.ID,
+		MusicTitle:     raw.Music.Title,
+
+		IsAIGenerated:   raw.IsAIGC,
+		AIGCDescription: raw.AIGCDescription,
+	}
+	if v.ShareURL == "" && v.Username != "" && v.ID != "" {
+		v.ShareURL = "https://www.tiktok.com/@" + v.Username + "/video/" + v.ID
+	}
+	for _, te := range raw.TextExtra {
+		switch {
+		case te.HashtagName != "":
+			v.Hashtags = append(v.Hashtags, te.HashtagName)
+		case te.UserID != "":
+			v.Mentions = append(v.Mentions, te.UserID)
+		}
 	}
+	for _, st := range raw.StickersOnItem {
+		if st.StickerID != "" {
+			v.StickerIDs = append(v.StickerIDs, st.StickerID)
+		}
+		v.StickerTexts = append(v.StickerTexts, st.StickerText...)
+	}
+	for _, e := range raw.EffectStickers {
+		if e.ID != "" {
+			v.EffectID = e.ID
+			break
+		}
+	}
+	return v
+}
+
+// parseVideoQualities converts raw bitrate entries to VideoQuality values.
+// Entries without a play URL are skipped.
+func parseVideoQualities(raw []rawBitrateInfo) []VideoQuality {
+	if len(raw) == 0 {
+		return nil
+	}
+	qualities := make([]VideoQuality, 0, len(raw))
+	for _, b := range raw {
+		if len(b.PlayAddr.URLList) == 0 {
+			continue
+		}
+		qualities = append(qualities, VideoQuality{
+			GearName:   b.GearName,
+			PlayURL:    b.PlayAddr.URLList[0],
+			Bitrate:    b.Bitrate,
+			FileSizeMB: float64(b.PlayAddr.DataSize) / (1 << 20),
+		})
+	}
+	return qualities
 }
 
 // parseAuthor converts raw SSR user info to the public Author type.
@@ -124,6 +565,7 @@ func parseAuthor(raw rawUserInfo) Author {
 	return Author{
 		ID:             raw.User.ID,
 		Username:       raw.User.UniqueID,
+		SecUID:         raw.User.SecUID,
 		Nickname:       raw.User.Nickname,
 		FollowerCount:  raw.Stats.FollowerCount,
 		FollowingCount: raw.Stats.FollowingCount,
@@ -131,7 +573,112 @@ func parseAuthor(raw rawUserInfo) Author {
 		HeartCount:     raw.Stats.HeartCount,
 		DiggCount:      raw.Stats.DiggCount,
 		Verified:       raw.User.Verified,
+		Private:        raw.User.PrivateAccount || raw.User.Secret,
 		Bio:            raw.User.Signature,
 		AvatarURL:      raw.User.AvatarLarger,
+		PinnedVideoIDs: raw.User.PinVideoIDs,
+	}
+}
+
+// parseSearchedChallenge converts a challenge search result to the public Hashtag type.
+func parseSearchedChallenge(raw rawSearchedChallengeInfo) Hashtag {
+	return Hashtag{
+		ID:          raw.CID,
+		Title:       raw.ChaName,
+		Description: raw.Desc,
+		VideoCount:  raw.UserCount,
+		ViewCount:   raw.ViewCount,
+	}
+}
+
+// parseChallengeInfo converts challenge detail data to the public Hashtag type.
+func parseChallengeInfo(raw rawChallengeInfo) Hashtag {
+	return Hashtag{
+		ID:          raw.Challenge.ID,
+		Title:       raw.Challenge.Title,
+		Description: raw.Challenge.Desc,
+		VideoCount:  raw.Stats.VideoCount,
+		ViewCount:   raw.Stats.ViewCount,
+	}
+}
+
+// parseComment converts a raw comment to the public Comment type.
+func parseComment(raw rawComment) Comment {
+	return Comment{
+		ID:             raw.CID,
+		Text:           raw.Text,
+		AuthorID:       raw.User.UID,
+		AuthorUsername: raw.User.UniqueID,
+		Likes:          raw.DiggCount,
+		ReplyCount:     raw.ReplyCommentTotal,
+		CreatedAt:      time.Unix(raw.CreateTime, 0),
+		IsAuthorLiked:  raw.IsAuthorDigged,
+	}
+}
+
+// parseSound converts raw music detail to the public Sound type.
+func parseSound(raw rawMusicInfo) Sound {
+	return Sound{
+		ID:         raw.Music.ID,
+		Title:      raw.Music.Title,
+		AuthorName: raw.Music.AuthorName,
+		Duration:   raw.Music.Duration,
+		CoverURL:   raw.Music.CoverLarge,
+		VideoCount: raw.Stats.VideoCount,
+	}
+}
+
+func parseEffect(raw rawEffectInfo) Effect {
+	return Effect{
+		ID:          raw.Effect.ID,
+		Name:        raw.Effect.Name,
+		Description: raw.Effect.Desc,
+		VideoCount:  raw.Stats.VideoCount,
+		CoverURL:    raw.Effect.CoverURL,
+	}
+}
+
+// parseAudienceStats converts raw creator audience stats to AudienceStats.
+func parseAudienceStats(raw rawAudienceStats) AudienceStats {
+	stats := AudienceStats{
+		GenderBreakdown:  distributionMap(raw.GenderDistribution),
+		AgeBreakdown:     distributionMap(raw.AgeDistribution),
+		TopCountries:     make([]string, 0, len(raw.CountryDistribution)),
+		AverageWatchTime: time.Duration(raw.AvgWatchTime * float64(time.Second)),
+	}
+	for _, c := range raw.CountryDistribution {
+		stats.TopCountries = append(stats.TopCountries, c.Key)
+	}
+	return stats
+}
+
+func distributionMap(items []rawDistributionItem) map[string]float64 {
+	m := make(map[string]float64, len(items))
+	for _, it := range items {
+		m[it.Key] = it.Value
+	}
+	return m
+}
+
+// parseLiveRoom converts a raw room to the public LiveStream type. Rooms
+// without a share URL link to the host's live page.
+func parseLiveRoom(raw rawLiveRoom) LiveStream {
+	ls := LiveStream{
+		ID:          raw.ID,
+		Title:       raw.Title,
+		Username:    raw.Owner.DisplayID,
+		AuthorID:    raw.Owner.ID,
+		ViewerCount: raw.UserCount,
+		ShareURL:    raw.ShareURL,
+	}
+	if raw.CreateTime != 0 {
+		ls.StartedAt = time.Unix(raw.CreateTime, 0)
+	}
+	if len(raw.Cover.URLList) > 0 {
+		ls.ThumbnailURL = raw.Cover.URLList[0]
+	}
+	if ls.ShareURL == "" && ls.Username != "" {
+		ls.ShareURL = "https://www.tiktok.com/@" + ls.Username + "/live"
 	}
+	return ls
 }
@@ -2,23 +2,38 @@ package tiktok
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"strconv"
+	"sync"
 	"time"
 )
 
 // GetUser fetches a TikTok user profile via SSR HTML parsing.
-// This is pure HTTP — no browser or login required.
-func (s *Scraper) GetUser(ctx context.Context, username string) (Author, error) {
+// This is pure HTTP — no browser or login required. With WithCache, repeat
+// lookups within the TTL skip the network and the profile rate limit.
+func (s *Scraper) GetUser(ctx context.Context, username string) (_ Author, err error) {
 	if username == "" {
 		return Author{}, fmt.Errorf("get user: username is required")
 	}
+	ctx, span := s.startSpan(ctx, "tiktok.GetUser")
+	span.setAttr("user", username)
+	defer func() { span.end(err) }()
 
 	totalStart := time.Now()
 	profileURL := s.baseURL + "/@" + username
 
+	if body, ok := s.cache.get(profileURL); ok {
+		return parseUserPage(username, body)
+	}
+
 	delayStart := time.Now()
-	s.waitForProfile()
+	if err := s.waitForProfile(ctx); err != nil {
+		return Author{}, fmt.Errorf("get user %q: %w", username, err)
+	}
 	delayDur := time.Since(delayStart)
 
 	httpStart := time.Now()
@@ -35,19 +50,549 @@ func (s *Scraper) GetUser(ctx context.Context, username string) (Author, error)
 	httpDur := time.Since(httpStart)
 
 	parseStart := time.Now()
+	author, err := parseUserPage(username, body)
+	if err != nil {
+		return Author{}, err
+	}
+	parseDur := time.Since(parseStart)
+	s.cache.put(profileURL, body)
+
+	s.logTiming(ctx, "GetUser", time.Since(totalStart),
+		slog.String("user", username), slog.Duration("delay", delayDur), slog.Duration("http", httpDur),
+		slog.Duration("parse", parseDur), slog.Int("bytes", len(body)))
+
+	return author, nil
+}
+
+// parseUserPage extracts the profile from a user page's SSR data.
+func parseUserPage(username string, body []byte) (Author, error) {
 	data, err := extractUniversalData(body)
 	if err != nil {
 		return Author{}, fmt.Errorf("parse user page %q: %w", username, err)
 	}
-
 	author, err := extractUserFromSSR(data)
 	if err != nil {
 		return Author{}, fmt.Errorf("extract user %q: %w", username, err)
 	}
-	parseDur := time.Since(parseStart)
+	return author, nil
+}
 
-	perfLog("GetUser: user=%s delay=%v http=%v parse=%v total=%v body=%d bytes",
-		username, delayDur, httpDur, parseDur, time.Since(totalStart), len(body))
+// defaultBatchConcurrency is the default number of concurrent GetUser calls
+// in BatchGetUsers (see WithBatchConcurrency).
+const defaultBatchConcurrency = 5
 
-	return author, nil
+// BatchGetUsers fetches several profiles concurrently. Successful lookups are
+// returned in the first map and failures in the second, both keyed by
+// username. Each lookup goes through GetUser, so the profile rate limit
+// still applies across all workers.
+func (s *Scraper) BatchGetUsers(ctx context.Context, usernames []string) (map[string]Author, map[string]error) {
+	users := make(map[string]Author, len(usernames))
+	errs := make(map[string]error)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.batchConcurrency)
+
+	for _, username := range usernames {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			author, err := s.GetUser(ctx, username)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[username] = err
+				return
+			}
+			users[username] = author
+		}()
+	}
+	wg.Wait()
+
+	return users, errs
+}
+
+// GetAccountRecommendations returns up to limit accounts TikTok suggests to
+// the logged-in user. Requires authentication.
+func (s *Scraper) GetAccountRecommendations(ctx context.Context, limit int) ([]Author, error) {
+	if !s.isLogged {
+		return nil, fmt.Errorf("get account recommendations: %w", ErrAuthRequired)
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	if err := s.waitForProfile(ctx); err != nil {
+		return nil, fmt.Errorf("get account recommendations: %w", err)
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/user/suggest/", func(p map[string]string) {
+		p["count"] = strconv.Itoa(limit)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get account recommendations: %w", err)
+	}
+
+	var result rawSuggestResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode account recommendations: %w", err)
+	}
+	if err := apiStatusError(result.StatusCode); err != nil {
+		return nil, fmt.Errorf("get account recommendations: %w", err)
+	}
+
+	users := make([]Author, 0, min(len(result.UserList), limit))
+	for _, raw := range result.UserList[:min(len(result.UserList), limit)] {
+		users = append(users, parseAuthor(raw.UserInfo))
+	}
+	return users, nil
+}
+
+// GetUserVideosCount returns the number of videos posted by the user with the
+// given secUid, read from the user detail API. Author.VideoCount comes from
+// SSR HTML that TikTok's CDN may serve stale: it is fine for discovery, but
+// use this when polling a creator for new posts. Requires an initialized
+// browser.
+func (s *Scraper) GetUserVideosCount(ctx context.Context, secUID string) (int64, error) {
+	if secUID == "" {
+		return 0, fmt.Errorf("get user videos count: sec uid is required")
+	}
+
+	info, err := s.fetchUserDetail(ctx, secUID)
+	if err != nil {
+		return 0, fmt.Errorf("get user videos count %q: %w", secUID, err)
+	}
+	return int64(info.Stats.VideoCount), nil
+}
+
+// GetUserBySecUID looks up a user by secUid via the user detail API, for
+// when only the secUid is known (e.g. from a video or comment). Returns
+// ErrNotFound for unknown users. Requires an initialized browser.
+func (s *Scraper) GetUserBySecUID(ctx context.Context, secUID string) (Author, error) {
+	if secUID == "" {
+		return Author{}, fmt.Errorf("get user by sec uid: sec uid is required")
+	}
+
+	info, err := s.fetchUserDetail(ctx, secUID)
+	if err != nil {
+		return Author{}, fmt.Errorf("get user by sec uid %q: %w", secUID, err)
+	}
+	return parseAuthor(info), nil
+}
+
+// fetchUserDetail fetches /api/user/detail/ for secUID, returning
+// ErrNotFound if TikTok has no such user and ErrBannedAccount for a
+// suspended one. Other status codes go through apiStatusError.
+func (s *Scraper) fetchUserDetail(ctx context.Context, secUID string) (rawUserInfo, error) {
+	if err := s.waitForProfile(ctx); err != nil {
+		return rawUserInfo{}, err
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/user/detail/", func(p map[string]string) {
+		p["secUid"] = secUID
+	})
+	if err != nil {
+		return rawUserInfo{}, err
+	}
+
+	var result rawUserDetailResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return rawUserInfo{}, fmt.Errorf("decode user detail: %w", err)
+	}
+	switch result.StatusCode {
+	case statusUserNotFound:
+		return rawUserInfo{}, fmt.Errorf("status %d: %w", result.StatusCode, ErrNotFound)
+	case statusBannedAccount:
+		return rawUserInfo{}, fmt.Errorf("status %d: %w", result.StatusCode, ErrBannedAccount)
+	}
+	if err := apiStatusError(result.StatusCode); err != nil {
+		return rawUserInfo{}, err
+	}
+	if result.UserInfo.User.ID == "" {
+		return rawUserInfo{}, fmt.Errorf("empty user info: %w", ErrNotFound)
+	}
+	return result.UserInfo, nil
+}
+
+// GetUserVideos returns up to limit videos posted by the user, newest first.
+// The profile is fetched first to resolve the user's secUid; callers that
+// already have it (Author.SecUID) should use GetUserVideosBySecUID instead.
+// Requires an initialized browser.
+func (s *Scraper) GetUserVideos(ctx context.Context, username string, limit int) ([]Video, error) {
+	if username == "" {
+		return nil, fmt.Errorf("get user videos: username is required")
+	}
+
+	author, err := s.GetUser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("get user videos %q: %w", username, err)
+	}
+	return s.GetUserVideosBySecUID(ctx, author.SecUID, limit)
+}
+
+// GetUserVideosBySecUID returns up to limit videos posted by the user with the
+// given secUid, newest first. With WithStatsEnrichment enabled, each video's
+// stats are refreshed via GetVideoByID. Requires an initialized browser.
+func (s *Scraper) GetUserVideosBySecUID(ctx context.Context, secUID string, limit int) ([]Video, error) {
+	if secUID == "" {
+		return nil, fmt.Errorf("get user videos: sec uid is required")
+	}
+
+	videos, err := s.listUserVideos(ctx, secUID, limit)
+	if err != nil || !s.statsEnrichment {
+		return videos, err
+	}
+	return s.enrichVideoStats(ctx, videos)
+}
+
+// GetUserVideosWithStats is GetUserVideos followed by a per-video stats
+// refresh via GetVideoByID, regardless of WithStatsEnrichment. The post list
+// can carry stale or incomplete stats for large creators; this doubles the
+// number of API calls to get current view counts.
+func (s *Scraper) GetUserVideosWithStats(ctx context.Context, username string, limit int) ([]Video, error) {
+	if username == "" {
+		return nil, fmt.Errorf("get user videos with stats: username is required")
+	}
+
+	author, err := s.GetUser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("get user videos with stats %q: %w", username, err)
+	}
+	videos, err := s.listUserVideos(ctx, author.SecUID, limit)
+	if err != nil {
+		return videos, err
+	}
+	return s.enrichVideoStats(ctx, videos)
+}
+
+// GetUserLikedVideos returns up to limit videos the user has liked. Returns
+// ErrPrivateAccount when the user keeps their likes private (the default).
+// The profile is fetched once to resolve the secUid. Requires an initialized
+// browser.
+func (s *Scraper) GetUserLikedVideos(ctx context.Context, username string, limit int) ([]Video, error) {
+	if username == "" {
+		return nil, fmt.Errorf("get user liked videos: username is required")
+	}
+
+	author, err := s.GetUser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("get user liked videos %q: %w", username, err)
+	}
+
+	var allVideos []Video
+	var cursor Cursor
+
+	for len(allVideos) < limit {
+		if err := s.waitForProfile(ctx); err != nil {
+			return allVideos, fmt.Errorf("get user liked videos %q: %w", username, err)
+		}
+
+		videos, nextCursor, err := s.fetchUserLikedVideos(ctx, author.SecUID, cursor)
+		if err != nil {
+			return allVideos, fmt.Errorf("get user liked videos %q: %w", username, err)
+		}
+		allVideos = append(allVideos, videos...)
+		s.reportProgress(len(allVideos), limit)
+		if nextCursor.IsZero() {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(allVideos) > limit {
+		allVideos = allVideos[:limit]
+	}
+	return allVideos, nil
+}
+
+func (s *Scraper) fetchUserLikedVideos(ctx context.Context, secUID string, cursor Cursor) ([]Video, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/favorite/item_list/", func(p map[string]string) {
+		p["secUid"] = secUID
+		p["count"] = "30"
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("liked videos: %w", err)
+	}
+
+	var result favoriteItemListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode liked videos: %w", err)
+	}
+	if result.StatusCode == statusPrivateAccount {
+		return nil, Cursor{}, ErrPrivateAccount
+	}
+
+	videos := make([]Video, 0, len(result.ItemList))
+	for _, raw := range result.ItemList {
+		videos = append(videos, parseVideo(raw))
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = result.Cursor
+	}
+	return videos, nextCursor, nil
+}
+
+// Scenes of the /api/user/list/ endpoint.
+const (
+	userListSceneFollowing = 21
+	userListSceneFollowers = 67
+)
+
+// GetUserFollowers returns up to maxCount accounts following username.
+// Returns ErrPrivateAccount when the list is hidden. Requires an initialized
+// browser.
+func (s *Scraper) GetUserFollowers(ctx context.Context, username string, maxCount int) ([]Author, error) {
+	authors, err := s.getUserList(ctx, username, userListSceneFollowers, maxCount)
+	if err != nil {
+		return authors, fmt.Errorf("get user followers %q: %w", username, err)
+	}
+	return authors, nil
+}
+
+// GetUserFollowing returns up to maxCount accounts username follows.
+// Returns ErrPrivateAccount when the list is hidden. Requires an initialized
+// browser.
+func (s *Scraper) GetUserFollowing(ctx context.Context, username string, maxCount int) ([]Author, error) {
+	authors, err := s.getUserList(ctx, username, userListSceneFollowing, maxCount)
+	if err != nil {
+		return authors, fmt.Errorf("get user following %q: %w", username, err)
+	}
+	return authors, nil
+}
+
+// getUserList resolves the user's secUid once, then pages /api/user/list/
+// for the given scene.
+func (s *Scraper) getUserList(ctx context.Context, username string, scene, maxCount int) ([]Author, error) {
+	if username == "" {
+		return nil, fmt.Errorf("username is required")
+	}
+
+	author, err := s.GetUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []Author
+	cursor := compoundCursor(0, 0)
+
+	for len(all) < maxCount {
+		if err := s.waitForProfile(ctx); err != nil {
+			return all, err
+		}
+
+		authors, nextCursor, err := s.fetchUserList(ctx, author.SecUID, scene, cursor)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, authors...)
+		if nextCursor.IsZero() {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(all) > maxCount {
+		all = all[:maxCount]
+	}
+	return all, nil
+}
+
+func (s *Scraper) fetchUserList(ctx context.Context, secUID string, scene int, cursor Cursor) ([]Author, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/user/list/", func(p map[string]string) {
+		p["secUid"] = secUID
+		p["scene"] = strconv.Itoa(scene)
+		p["count"] = "30"
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("user list: %w", err)
+	}
+
+	var result userListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode user list: %w", err)
+	}
+	if result.StatusCode == statusPrivateAccount {
+		return nil, Cursor{}, ErrPrivateAccount
+	}
+
+	authors := make([]Author, 0, len(result.UserList))
+	for _, raw := range result.UserList {
+		authors = append(authors, parseAuthor(raw))
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = compoundCursor(result.MinCursor, 0)
+	}
+	return authors, nextCursor, nil
+}
+
+// GetUserPinnedVideos returns the videos the user has pinned to the top of
+// their profile, in profile order, or nil if none are pinned. The (at most
+// three) videos are fetched concurrently via GetVideoByID; videos that fail
+// are left out and their errors returned joined. Requires an initialized
+// browser.
+func (s *Scraper) GetUserPinnedVideos(ctx context.Context, username string) ([]Video, error) {
+	if username == "" {
+		return nil, fmt.Errorf("get user pinned videos: username is required")
+	}
+
+	author, err := s.GetUser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("get user pinned videos %q: %w", username, err)
+	}
+
+	ids := author.PinnedVideoIDs
+	videos := make([]Video, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			videos[i], errs[i] = s.GetVideoByID(ctx, id)
+		}()
+	}
+	wg.Wait()
+
+	pinned := make([]Video, 0, len(ids))
+	for i := range ids {
+		if errs[i] == nil {
+			pinned = append(pinned, videos[i])
+		}
+	}
+	if len(pinned) == 0 {
+		pinned = nil
+	}
+	return pinned, errors.Join(errs...)
+}
+
+// HasNewVideoSince reports whether the user has posted after since, checking
+// only the first page of their posts (no pagination). It returns the newest
+// such video, or false and a nil video if there is none. This is the cheapest
+// new-content check for high-frequency monitoring: one profile fetch plus one
+// API call. Requires an initialized browser.
+func (s *Scraper) HasNewVideoSince(ctx context.Context, username string, since time.Time) (bool, *Video, error) {
+	if username == "" {
+		return false, nil, fmt.Errorf("has new video since: username is required")
+	}
+
+	author, err := s.GetUser(ctx, username)
+	if err != nil {
+		return false, nil, fmt.Errorf("has new video since %q: %w", username, err)
+	}
+
+	if err := s.waitForProfile(ctx); err != nil {
+		return false, nil, fmt.Errorf("has new video since %q: %w", username, err)
+	}
+	videos, _, err := s.fetchUserVideos(ctx, author.SecUID, Cursor{})
+	if err != nil {
+		return false, nil, fmt.Errorf("has new video since %q: %w", username, err)
+	}
+
+	// Pinned videos can precede newer posts, so scan the whole page.
+	var newest *Video
+	for i := range videos {
+		if videos[i].CreatedAt.After(since) && (newest == nil || videos[i].CreatedAt.After(newest.CreatedAt)) {
+			newest = &videos[i]
+		}
+	}
+	return newest != nil, newest, nil
+}
+
+// listUserVideos pages through a user's posts until limit is reached.
+func (s *Scraper) listUserVideos(ctx context.Context, secUID string, limit int) ([]Video, error) {
+	var allVideos []Video
+	var cursor Cursor
+
+	for len(allVideos) < limit {
+		if err := s.waitForProfile(ctx); err != nil {
+			return allVideos, fmt.Errorf("fetch user videos %q: %w", secUID, err)
+		}
+
+		videos, nextCursor, err := s.fetchUserVideos(ctx, secUID, cursor)
+		if err != nil {
+			return allVideos, fmt.Errorf("fetch user videos %q: %w", secUID, err)
+		}
+		allVideos = append(allVideos, videos...)
+		s.reportProgress(len(allVideos), limit)
+		if nextCursor.IsZero() {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(allVideos) > limit {
+		allVideos = allVideos[:limit]
+	}
+	return allVideos, nil
+}
+
+func (s *Scraper) fetchUserVideos(ctx context.Context, secUID string, cursor Cursor) ([]Video, Cursor, error) {
+	body, err := s.browserAPIRequest(ctx, "/api/post/item_list/", func(p map[string]string) {
+		p["secUid"] = secUID
+		p["count"] = "35"
+		cursor.setParams(p)
+	})
+	if err != nil {
+		return nil, Cursor{}, fmt.Errorf("user videos: %w", err)
+	}
+
+	var result postItemListResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Cursor{}, fmt.Errorf("decode user videos: %w", err)
+	}
+
+	videos := make([]Video, 0, len(result.ItemList))
+	for _, raw := range result.ItemList {
+		videos = append(videos, parseVideo(raw))
+	}
+
+	var nextCursor Cursor
+	if result.HasMore {
+		nextCursor = result.Cursor
+	}
+	return videos, nextCursor, nil
+}
+
+// statsEnrichmentConcurrency bounds concurrent GetVideoByID calls in
+// enrichVideoStats. Browser fetches are still serialized by browserMu unless
+// WithBrowserPoolSize is set.
+const statsEnrichmentConcurrency = 5
+
+// enrichVideoStats refreshes each video's engagement stats from the item
+// detail endpoint. Videos whose refresh fails keep their original stats and
+// the failures are returned joined.
+func (s *Scraper) enrichVideoStats(ctx context.Context, videos []Video) ([]Video, error) {
+	errs := make([]error, len(videos))
+	sem := make(chan struct{}, statsEnrichmentConcurrency)
+	var wg sync.WaitGroup
+
+	for i := range videos {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			fresh, err := s.GetVideoByID(ctx, videos[i].ID)
+			if err != nil {
+				errs[i] = fmt.Errorf("enrich video %q: %w", videos[i].ID, err)
+				return
+			}
+			videos[i].Views = fresh.Views
+			videos[i].Likes = fresh.Likes
+			videos[i].Comments = fresh.Comments
+			videos[i].Shares = fresh.Shares
+		}()
+	}
+	wg.Wait()
+
+	return videos, errors.Join(errs...)
 }
@@ -0,0 +1,207 @@
+package tiktok
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// GetVideoByID fetches full metadata for a single video. Returns
+// ErrVideoUnavailable when TikTok reports the video as removed, and
+// ErrNotFound when no video data comes back (e.g. a private video).
+// Requires an initialized browser.
+func (s *Scraper) GetVideoByID(ctx context.Context, videoID string) (Video, error) {
+	if videoID == "" {
+		return Video{}, fmt.Errorf("get video: video id is required")
+	}
+
+	item, err := s.fetchItemDetail(ctx, videoID)
+	if err != nil {
+		return Video{}, err
+	}
+	return parseVideo(item), nil
+}
+
+// fetchItemDetail fetches the raw item detail for videoID.
+func (s *Scraper) fetchItemDetail(ctx context.Context, videoID string) (rawVideo, error) {
+	if err := s.waitForSearch(ctx); err != nil {
+		return rawVideo{}, fmt.Errorf("get video %q: %w", videoID, err)
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/item/detail/", func(p map[string]string) {
+		p["itemId"] = videoID
+	})
+	if err != nil {
+		return rawVideo{}, fmt.Errorf("get video %q: %w", videoID, err)
+	}
+
+	var result itemDetailResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return rawVideo{}, fmt.Errorf("decode video %q: %w", videoID, err)
+	}
+
+	if result.StatusCode == statusVideoUnavailable {
+		return rawVideo{}, fmt.Errorf("get video %q: %w", videoID, ErrVideoUnavailable)
+	}
+	item := result.ItemInfo.ItemStruct
+	if item.ID == "" {
+		return rawVideo{}, fmt.Errorf("get video %q: %w", videoID, ErrNotFound)
+	}
+	return item, nil
+}
+
+// GetVideoSubtitles returns the subtitle tracks of a video with their cues
+// parsed from the WebVTT (or SRT) files. A video without subtitles yields an
+// empty slice. Errors are as for GetVideoByID; a subtitle file that cannot
+// be downloaded or parsed fails the whole call. Requires an initialized
+// browser.
+func (s *Scraper) GetVideoSubtitles(ctx context.Context, videoID string) ([]Subtitle, error) {
+	if videoID == "" {
+		return nil, fmt.Errorf("get video subtitles: video id is required")
+	}
+
+	item, err := s.fetchItemDetail(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	subtitles := make([]Subtitle, 0, len(item.Video.Subtitles))
+	for _, raw := range item.Video.Subtitles {
+		sub := Subtitle{
+			Language:   raw.LanguageCodeName,
+			LanguageID: raw.LanguageID.String(),
+			URL:        raw.URL,
+			Format:     raw.Format,
+		}
+		if sub.Cues, err = s.fetchSubtitleCues(ctx, sub.URL); err != nil {
+			return nil, fmt.Errorf("get video subtitles %q (%s): %w", videoID, sub.Language, err)
+		}
+		subtitles = append(subtitles, sub)
+	}
+	return subtitles, nil
+}
+
+// fetchSubtitleCues downloads a subtitle file from TikTok's CDN and parses it.
+func (s *Scraper) fetchSubtitleCues(ctx context.Context, fileURL string) ([]SubtitleCue, error) {
+	resp, err := s.doRequest(ctx, "GET", fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: http %d", ErrInvalidResponse, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read subtitles: %w", err)
+	}
+	return parseSubtitleCues(body)
+}
+
+// GetSimilarVideos returns up to limit videos TikTok recommends alongside
+// the given one. The endpoint is not paginated, so fewer than limit videos may
+// come back. Returns ErrVideoUnavailable when TikTok reports the video as
+// removed or recommends nothing for it. Requires an initialized browser.
+func (s *Scraper) GetSimilarVideos(ctx context.Context, videoID string, limit int) ([]Video, error) {
+	if videoID == "" {
+		return nil, fmt.Errorf("get similar videos: video id is required")
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return nil, fmt.Errorf("get similar videos %q: %w", videoID, err)
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/related/item_list/", func(p map[string]string) {
+		p["itemId"] = videoID
+		p["count"] = strconv.Itoa(limit)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get similar videos %q: %w", videoID, err)
+	}
+
+	var result searchResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("decode similar videos %q: %w", videoID, err)
+	}
+	if result.StatusCode == statusVideoUnavailable || len(result.ItemList) == 0 {
+		return nil, fmt.Errorf("get similar videos %q: %w", videoID, ErrVideoUnavailable)
+	}
+
+	items := result.ItemList
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	videos := make([]Video, 0, len(items))
+	for _, raw := range items {
+		videos = append(videos, parseVideo(raw))
+	}
+	return videos, nil
+}
+
+// GetVideoChain follows TikTok's "next video" suggestions starting at
+// startVideoID and returns up to length videos, including the start video.
+// The chain stops early when a video has no suggestion or a suggestion
+// points back to a video already in the chain.
+func (s *Scraper) GetVideoChain(ctx context.Context, startVideoID string, length int) ([]Video, error) {
+	if startVideoID == "" {
+		return nil, fmt.Errorf("get video chain: start video id is required")
+	}
+	if length <= 0 {
+		return nil, nil
+	}
+
+	chain := make([]Video, 0, length)
+	seen := make(map[string]bool, length)
+
+	for id := startVideoID; id != "" && !seen[id] && len(chain) < length; {
+		v, err := s.GetVideoByID(ctx, id)
+		if err != nil {
+			return chain, fmt.Errorf("get video chain at %d: %w", len(chain), err)
+		}
+		chain = append(chain, v)
+		seen[id] = true
+		id = v.NextVideoID
+	}
+	return chain, nil
+}
+
+// GetVideoAudienceStats fetches audience demographics for one of the logged-in
+// creator's own videos. Requires a Creator account session; returns
+// ErrAuthRequired when not logged in or when TikTok refuses access.
+func (s *Scraper) GetVideoAudienceStats(ctx context.Context, videoID string) (AudienceStats, error) {
+	if videoID == "" {
+		return AudienceStats{}, fmt.Errorf("get audience stats: video id is required")
+	}
+	if !s.isLogged {
+		return AudienceStats{}, fmt.Errorf("get audience stats: %w", ErrAuthRequired)
+	}
+
+	if err := s.waitForSearch(ctx); err != nil {
+		return AudienceStats{}, fmt.Errorf("get audience stats %q: %w", videoID, err)
+	}
+
+	body, err := s.browserAPIRequest(ctx, "/api/creator/video/stats/", func(p map[string]string) {
+		p["video_id"] = videoID
+	})
+	if err != nil {
+		return AudienceStats{}, fmt.Errorf("get audience stats %q: %w", videoID, err)
+	}
+
+	var result rawAudienceStatsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return AudienceStats{}, fmt.Errorf("decode audience stats %q: %w", videoID, err)
+	}
+	// The endpoint only fails for sessions without creator tool access.
+	if result.StatusCode != 0 {
+		return AudienceStats{}, fmt.Errorf("get audience stats %q: %w: status_code %d: %s",
+			videoID, ErrAuthRequired, result.StatusCode, result.StatusMsg)
+	}
+	return parseAudienceStats(result.Data), nil
+}